@@ -2,31 +2,72 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"flag"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/basedalex/merch-shop/internal/apikey"
+	"github.com/basedalex/merch-shop/internal/auth"
 	"github.com/basedalex/merch-shop/internal/config"
 	"github.com/basedalex/merch-shop/internal/db"
+	"github.com/basedalex/merch-shop/internal/denylist"
+	"github.com/basedalex/merch-shop/internal/docs"
+	"github.com/basedalex/merch-shop/internal/health"
+	"github.com/basedalex/merch-shop/internal/idempotency"
+	"github.com/basedalex/merch-shop/internal/identity"
+	"github.com/basedalex/merch-shop/internal/logging"
+	"github.com/basedalex/merch-shop/internal/metrics"
 	"github.com/basedalex/merch-shop/internal/middleware"
+	"github.com/basedalex/merch-shop/internal/password"
+	"github.com/basedalex/merch-shop/internal/ratelimit"
 	"github.com/basedalex/merch-shop/internal/service"
 	api "github.com/basedalex/merch-shop/internal/swagger"
+	"github.com/basedalex/merch-shop/internal/tracing"
 	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 )
 
 func main() {
+	configPath := flag.String("config", "./config.dev.yaml", "path to the configuration file")
+	overrides := config.Overrides{}
+	flag.Var(overrides, "set", "override a configuration value by its YAML path, e.g. -set server.port=8081 (repeatable)")
+	flag.Parse()
+
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	cfg, err := config.Init("./config.dev.yaml")
+	cfg, err := config.Load(*configPath, overrides)
 	if err != nil {
 		log.Fatal("Error loading config: ", err)
 		return
 	}
 
+	if err := logging.Setup(cfg.Log); err != nil {
+		log.Fatal("Error configuring logging: ", err)
+		return
+	}
+	go logging.ReopenOnSIGHUP(ctx)
+
+	watcher := config.NewWatcher(*configPath, overrides, cfg)
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		log.Fatal("Error setting up tracing: ", err)
+		return
+	}
+
+	if err := auth.Init(cfg); err != nil {
+		log.Fatal("Error loading signing keys: ", err)
+		return
+	}
+
 	database, err := db.NewPostgres(ctx, cfg)
 	if err != nil {
 		log.Fatal("Error connecting to database: ", err)
@@ -34,31 +75,164 @@ func main() {
 	}
 	log.Info("connected to database")
 
-	server := service.NewService(database)
+	revoked := denylist.New(database)
+	if err := revoked.Sync(ctx); err != nil {
+		log.Fatal("Error loading token denylist: ", err)
+		return
+	}
+	go revoked.Run(ctx, cfg.Auth.DenylistRefresh)
+
+	passwords, err := password.NewPolicy(cfg.Auth.Password)
+	if err != nil {
+		log.Fatal("Error loading password policy: ", err)
+		return
+	}
+
+	var sso identity.RedirectProvider
+	if cfg.Auth.OIDC.Issuer != "" {
+		provider, err := identity.NewOIDC(ctx, cfg.Auth.OIDC)
+		if err != nil {
+			log.Fatal("Error setting up single sign-on: ", err)
+			return
+		}
+
+		sso = provider
+	}
+
+	metrics.Registry.MustRegister(metrics.NewDBCollector(database))
+
+	server := service.NewService(database, revoked, passwords, sso, cfg)
+	checker := health.NewChecker(database)
+
+	rateLimits := ratelimit.NewMemoryStore()
+	go rateLimits.Run(ctx, time.Minute)
+	limiter := ratelimit.NewLimiter(rateLimits, cfg.RateLimit)
+
+	go idempotency.Purge(ctx, database, time.Hour)
+
+	watcher.OnReload(func(reloaded *config.Config) {
+		if err := logging.Setup(reloaded.Log); err != nil {
+			log.Error("Error applying log configuration: ", err)
+		}
+		server.Reload(reloaded)
+		limiter.SetLimits(reloaded.RateLimit)
+	})
+	go watcher.Run(ctx, config.WatchInterval)
+
 	r := chi.NewRouter()
-	r.Use(middleware.Authentication)
-	api.HandlerFromMux(server, r)
+	r.Use(middleware.RequestID, middleware.Tracing, middleware.AccessLog(logging.Logger("access")), middleware.Metrics, middleware.Recoverer)
+	r.Use(middleware.Authentication(revoked, apikey.NewVerifier(database)))
+	r.Get("/healthz", checker.Liveness)
+	r.Get("/readyz", checker.Readiness)
 
-	srv := &http.Server{
-		Addr:    ":8080",
-		Handler: r,
+	spec, err := api.GetSwagger()
+	if err != nil {
+		log.Fatal("Error loading OpenAPI spec: ", err)
+		return
+	}
+
+	if cfg.Docs.Enabled {
+		if err := docs.Routes(r, spec); err != nil {
+			log.Fatal("Error serving OpenAPI spec: ", err)
+			return
+		}
+	}
+
+	// the last one runs first: rate limiting, authorization, idempotency keys, then validation
+	middlewares := []api.MiddlewareFunc{
+		middleware.Idempotency(database, cfg.Idempotency.TTL, service.IdempotentRoutes),
+		middleware.Authorize(service.AccessPolicy, service.ScopePolicy),
+		middleware.RateLimit(limiter),
+	}
+	if cfg.Validation.Requests {
+		middlewares = append([]api.MiddlewareFunc{middleware.Validation(spec, cfg.Validation.Responses)}, middlewares...)
 	}
 
+	api.HandlerWithOptions(service.NewHandler(server), api.ChiServerOptions{
+		BaseRouter:  r,
+		Middlewares: middlewares,
+	})
+
+	srv := newServer(cfg.Server, r)
+
 	go func() {
-		log.Println("Server listening on port 8080")
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if cfg.Server.TLSEnabled() {
+			log.Printf("Server listening on %s (TLS)", srv.Addr)
+			err = srv.ListenAndServeTLS(cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+		} else {
+			log.Printf("Server listening on %s", srv.Addr)
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatal("ListenAndServe Error: ", err)
 		}
 	}()
 
+	var adminSrv *http.Server
+	if cfg.Admin.Port != 0 {
+		adminMux := http.NewServeMux()
+		adminMux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+		adminMux.Handle("/config", watcher.Handler())
+
+		adminSrv = &http.Server{
+			Addr:              net.JoinHostPort(cfg.Admin.Host, strconv.Itoa(cfg.Admin.Port)),
+			Handler:           adminMux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+
+		go func() {
+			log.Printf("Admin server listening on %s", adminSrv.Addr)
+			if err := adminSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal("Admin ListenAndServe Error: ", err)
+			}
+		}()
+	}
+
 	<-ctx.Done()
 
-	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+	checker.Drain()
+	log.Printf("Draining for %s before shutdown", cfg.Server.DrainDelay)
+	time.Sleep(cfg.Server.DrainDelay)
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancelShutdown()
 
 	log.Println("Shutting down server...")
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Fatal("Server Shutdown Error: ", err)
 	}
+
+	if adminSrv != nil {
+		if err := adminSrv.Shutdown(shutdownCtx); err != nil {
+			log.Error("Admin Server Shutdown Error: ", err)
+		}
+	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error("Error flushing traces: ", err)
+	}
 	log.Println("Server gracefully stopped")
-}
\ No newline at end of file
+}
+
+// newServer builds the public HTTP server. With TLS configured, HTTP/2 is negotiated over ALPN.
+func newServer(cfg config.Server, handler http.Handler) *http.Server {
+	srv := &http.Server{
+		Addr:              net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+
+	if cfg.TLSEnabled() {
+		srv.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			NextProtos: []string{"h2", "http/1.1"},
+		}
+	}
+
+	return srv
+}
@@ -0,0 +1,84 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/db"
+)
+
+// keyPrefix marks merch-shop API keys so secret scanners and humans can recognise them.
+const keyPrefix = "msk"
+
+var prefixEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type Store interface {
+	AuthenticateAPIKey(ctx context.Context, prefix, hash string) (*db.APIKey, error)
+}
+
+// Generate returns a new key in the form msk_<prefix>_<secret>, its prefix and the hash stored instead of it.
+// The prefix identifies the key in listings and logs without revealing it.
+func Generate() (key, prefix, hash string, err error) {
+	buf := make([]byte, 5)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", "", fmt.Errorf("error generating api key: %w", err)
+	}
+
+	prefix = strings.ToLower(prefixEncoding.EncodeToString(buf))
+
+	secret, _, err := auth.NewOpaqueToken()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	key = keyPrefix + "_" + prefix + "_" + secret
+
+	return key, prefix, auth.HashOpaqueToken(key), nil
+}
+
+// Prefix extracts the public part of a key.
+func Prefix(key string) (string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// Verifier authenticates requests that carry an X-API-Key header.
+type Verifier struct {
+	store Store
+}
+
+func NewVerifier(store Store) *Verifier {
+	return &Verifier{store: store}
+}
+
+func (v *Verifier) VerifyAPIKey(ctx context.Context, key string) (*auth.ServiceAccount, error) {
+	prefix, ok := Prefix(key)
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	apiKey, err := v.store.AuthenticateAPIKey(ctx, prefix, auth.HashOpaqueToken(key))
+	if err != nil {
+		if errors.Is(err, db.ErrAPIKeyNotFound) {
+			return nil, auth.ErrInvalidAPIKey
+		}
+
+		return nil, err
+	}
+
+	scopes := make([]auth.Scope, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		scopes[i] = auth.Scope(scope)
+	}
+
+	return &auth.ServiceAccount{Name: apiKey.Account, KeyPrefix: apiKey.Prefix, Scopes: scopes}, nil
+}
@@ -0,0 +1,31 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+)
+
+func TestGenerate(t *testing.T) {
+	key, prefix, hash, err := Generate()
+	require.NoError(t, err)
+
+	parsed, ok := Prefix(key)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, parsed)
+	assert.Equal(t, auth.HashOpaqueToken(key), hash)
+
+	other, _, _, err := Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestPrefix(t *testing.T) {
+	for _, key := range []string{"", "msk_", "msk__secret", "sk_abc_secret", "Bearer token"} {
+		_, ok := Prefix(key)
+		assert.False(t, ok, key)
+	}
+}
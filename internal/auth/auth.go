@@ -1,22 +1,83 @@
 package auth
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 )
 
-var secretKey = []byte("secret-key")
+var errKeysNotConfigured = errors.New("signing keys are not configured")
 
-func CreateToken(username string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"username": username,
-			"exp":      time.Now().Add(time.Hour * 24).Unix(),
-		})
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	MFATokenTTL     = 5 * time.Minute
+)
+
+// ScopeMFA marks the token issued between the password and the second factor.
+// It only lets the client finish the login through POST /api/auth/mfa.
+const ScopeMFA = "mfa"
+
+// Claims are the claims carried by an access token. StandardClaims.Id holds the jti
+// used to revoke a single token.
+type Claims struct {
+	Username string `json:"username"`
+	Role     Role   `json:"role"`
+	Scope    string `json:"scope,omitempty"`
+	// IssuedAtMicro is the issue time in microseconds, the precision of session revocations:
+	// iat has whole seconds only, which cannot order a token and a revocation of the same second.
+	IssuedAtMicro int64 `json:"iat_us,omitempty"`
+	jwt.StandardClaims
+}
+
+// IssuedAtTime returns the issue time, in whole seconds for tokens without IssuedAtMicro.
+func (c *Claims) IssuedAtTime() time.Time {
+	if c.IssuedAtMicro != 0 {
+		return time.UnixMicro(c.IssuedAtMicro)
+	}
+
+	return time.Unix(c.IssuedAt, 0)
+}
+
+func (c *Claims) ExpiresAtTime() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+func CreateToken(username string, role Role) (string, error) {
+	return createToken(Claims{Username: username, Role: role}, AccessTokenTTL)
+}
+
+// CreateMFAToken issues the short-lived token that proves the password step of a login
+// for an employee with two-factor authentication enabled.
+func CreateMFAToken(username string) (string, error) {
+	return createToken(Claims{Username: username, Scope: ScopeMFA}, MFATokenTTL)
+}
 
-	tokenString, err := token.SignedString(secretKey)
+func createToken(claims Claims, ttl time.Duration) (string, error) {
+	if keys == nil {
+		return "", errKeysNotConfigured
+	}
+
+	jti, err := randomString(16)
+	if err != nil {
+		return "", fmt.Errorf("error generating token id: %w", err)
+	}
+
+	now := time.Now()
+	claims.IssuedAtMicro = now.UnixMicro()
+	claims.StandardClaims = jwt.StandardClaims{
+		Id:        jti,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+
+	tokenString, err := keys.sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -24,38 +85,94 @@ func CreateToken(username string) (string, error) {
 	return tokenString, nil
 }
 
-func VerifyToken(tokenString string) error {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return secretKey, nil
-	})
+// NewOpaqueToken returns a random token for the client (refresh tokens, invite codes)
+// and the hash under which it is stored on the server side.
+func NewOpaqueToken() (token, hash string, err error) {
+	token, err = randomString(32)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating token: %w", err)
+	}
+
+	return token, HashOpaqueToken(token), nil
+}
+
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseToken verifies the access token signature and expiry and returns its claims.
+func ParseToken(tokenString string) (*Claims, error) {
+	claims, err := parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Scope != "" {
+		return nil, fmt.Errorf("not an access token")
+	}
+
+	return claims, nil
+}
+
+// ParseMFAToken verifies a token issued by CreateMFAToken.
+func ParseMFAToken(tokenString string) (*Claims, error) {
+	claims, err := parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Scope != ScopeMFA {
+		return nil, fmt.Errorf("not an mfa token")
+	}
+
+	return claims, nil
+}
+
+func parseToken(tokenString string) (*Claims, error) {
+	if keys == nil {
+		return nil, errKeysNotConfigured
+	}
+
+	claims := &Claims{}
 
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.keyFunc)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !token.Valid {
-		return fmt.Errorf("invalid token")
+		return nil, fmt.Errorf("invalid token")
 	}
 
-	return nil
+	return claims, nil
 }
 
-func ExtractUsername(tokenString string) (string, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return secretKey, nil
-	})
+func VerifyToken(tokenString string) error {
+	_, err := ParseToken(tokenString)
+
+	return err
+}
 
+func ExtractUsername(tokenString string) (string, error) {
+	claims, err := ParseToken(tokenString)
 	if err != nil {
 		return "", err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		username, ok := claims["username"].(string)
-		if !ok {
-			return "", fmt.Errorf("username not found in token")
-		}
-		return username, nil
+	if claims.Username == "" {
+		return "", fmt.Errorf("username not found in token")
+	}
+
+	return claims.Username, nil
+}
+
+func randomString(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
 	}
 
-	return "", fmt.Errorf("invalid token claims")
+	return base64.RawURLEncoding.EncodeToString(buf), nil
 }
@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Key is a single JWT key identified by the kid header of the tokens it signs.
+type Key struct {
+	ID     string
+	Method jwt.SigningMethod
+
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// KeySet holds every key that is still accepted for verification
+// and the one used to sign new tokens.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+var keys *KeySet
+
+// Init loads the signing keys from the configuration.
+func Init(cfg *config.Config) error {
+	ks, err := NewKeySet(cfg.Auth.SigningKeyID, cfg.Auth.Keys)
+	if err != nil {
+		return err
+	}
+
+	keys = ks
+
+	return nil
+}
+
+func NewKeySet(signingKeyID string, cfgs []config.SigningKey) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key, len(cfgs))}
+
+	for _, cfg := range cfgs {
+		if cfg.ID == "" {
+			return nil, fmt.Errorf("signing key without id")
+		}
+
+		if _, ok := ks.keys[cfg.ID]; ok {
+			return nil, fmt.Errorf("duplicate signing key id %q", cfg.ID)
+		}
+
+		key, err := loadKey(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error loading key %q: %w", cfg.ID, err)
+		}
+
+		ks.keys[key.ID] = key
+	}
+
+	signing, ok := ks.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q is not configured", signingKeyID)
+	}
+
+	if signing.signKey == nil {
+		return nil, fmt.Errorf("signing key %q has no private key", signingKeyID)
+	}
+
+	ks.signing = signing
+
+	return ks, nil
+}
+
+func loadKey(cfg config.SigningKey) (*Key, error) {
+	key := &Key{ID: cfg.ID}
+
+	switch cfg.Algorithm {
+	case AlgHS256:
+		if cfg.Secret == "" {
+			return nil, fmt.Errorf("HS256 key requires a secret")
+		}
+
+		key.Method = jwt.SigningMethodHS256
+		key.signKey = []byte(cfg.Secret)
+		key.verifyKey = key.signKey
+	case AlgRS256:
+		key.Method = jwt.SigningMethodRS256
+
+		if cfg.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(cfg.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+
+			private, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+
+			key.signKey = private
+			key.verifyKey = &private.PublicKey
+		} else {
+			pem, err := readPublicKeyFile(cfg)
+			if err != nil {
+				return nil, err
+			}
+
+			public, err := jwt.ParseRSAPublicKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+
+			key.verifyKey = public
+		}
+	case AlgEdDSA:
+		key.Method = jwt.SigningMethodEdDSA
+
+		if cfg.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(cfg.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+
+			private, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+
+			edPrivate, ok := private.(ed25519.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("not an Ed25519 private key")
+			}
+
+			key.signKey = edPrivate
+			key.verifyKey = edPrivate.Public()
+		} else {
+			pem, err := readPublicKeyFile(cfg)
+			if err != nil {
+				return nil, err
+			}
+
+			public, err := jwt.ParseEdPublicKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+
+			key.verifyKey = public
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", cfg.Algorithm)
+	}
+
+	return key, nil
+}
+
+func readPublicKeyFile(cfg config.SigningKey) ([]byte, error) {
+	if cfg.PublicKeyFile == "" {
+		return nil, fmt.Errorf("%s key requires privateKeyFile or publicKeyFile", cfg.Algorithm)
+	}
+
+	return os.ReadFile(cfg.PublicKeyFile)
+}
+
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.Method, claims)
+	token.Header["kid"] = ks.signing.ID
+
+	return token.SignedString(ks.signing.signKey)
+}
+
+// keyFunc picks the verification key by kid and refuses tokens whose alg
+// does not match the key, so a public key can never be used as an HMAC secret.
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for key %q", token.Method.Alg(), kid)
+	}
+
+	return key.verifyKey, nil
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public halves of the asymmetric keys. HS256 secrets are never published,
+// so services that verify tokens on their own need an RS256 or EdDSA key to be configured.
+func JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	if keys == nil {
+		return set
+	}
+
+	for _, key := range keys.keys {
+		if jwk, ok := toJWK(key); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+
+	return set
+}
+
+func toJWK(key *Key) (JWK, bool) {
+	jwk := JWK{KeyID: key.ID, Use: "sig", Algorithm: key.Method.Alg()}
+
+	switch public := key.verifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	default:
+		return JWK{}, false
+	}
+
+	return jwk, true
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+func writePEM(t *testing.T, name, blockType string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+
+	return path
+}
+
+func useKeys(t *testing.T, signingKeyID string, cfgs ...config.SigningKey) {
+	t.Helper()
+
+	ks, err := NewKeySet(signingKeyID, cfgs)
+	require.NoError(t, err)
+
+	previous := keys
+	keys = ks
+
+	t.Cleanup(func() { keys = previous })
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey := config.SigningKey{ID: "old", Algorithm: AlgHS256, Secret: "old-secret"}
+	newKey := config.SigningKey{ID: "new", Algorithm: AlgHS256, Secret: "new-secret"}
+
+	useKeys(t, "old", oldKey)
+	oldToken, err := CreateToken("alice", RoleEmployee)
+	require.NoError(t, err)
+
+	useKeys(t, "new", oldKey, newKey)
+	newToken, err := CreateToken("bob", RoleEmployee)
+	require.NoError(t, err)
+
+	username, err := ExtractUsername(oldToken)
+	require.NoError(t, err, "tokens of the previous key keep verifying")
+	assert.Equal(t, "alice", username)
+
+	username, err = ExtractUsername(newToken)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", username)
+
+	useKeys(t, "new", newKey)
+	_, err = ParseToken(oldToken)
+	assert.Error(t, err, "tokens of a removed key are rejected")
+}
+
+func TestAsymmetricKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	rsaFile := writePEM(t, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+	edFile := writePEM(t, "ed.pem", "PRIVATE KEY", edDER)
+
+	cfgs := []config.SigningKey{
+		{ID: "hs", Algorithm: AlgHS256, Secret: "secret"},
+		{ID: "rs", Algorithm: AlgRS256, PrivateKeyFile: rsaFile},
+		{ID: "ed", Algorithm: AlgEdDSA, PrivateKeyFile: edFile},
+	}
+
+	for _, kid := range []string{"rs", "ed"} {
+		useKeys(t, kid, cfgs...)
+
+		token, err := CreateToken("alice", RoleEmployee)
+		require.NoError(t, err)
+
+		parsed, _, err := new(jwt.Parser).ParseUnverified(token, &Claims{})
+		require.NoError(t, err)
+		assert.Equal(t, kid, parsed.Header["kid"])
+
+		username, err := ExtractUsername(token)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", username)
+	}
+
+	jwks := JWKS()
+	require.Len(t, jwks.Keys, 2, "HS256 secrets are not published")
+	assert.Equal(t, "ed", jwks.Keys[0].KeyID)
+	assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+	assert.Equal(t, "rs", jwks.Keys[1].KeyID)
+	assert.Equal(t, "RSA", jwks.Keys[1].KeyType)
+}
+
+func TestAlgorithmMismatchIsRejected(t *testing.T) {
+	useKeys(t, "hs", config.SigningKey{ID: "hs", Algorithm: AlgHS256, Secret: "secret"})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, Claims{Username: "mallory"})
+	token.Header["kid"] = "hs"
+
+	tokenString, err := token.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = ParseToken(tokenString)
+	assert.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package auth
+
+import "context"
+
+type Role string
+
+const (
+	RoleEmployee     Role = "employee"
+	RoleShopManager  Role = "shop-manager"
+	RoleFinanceAdmin Role = "finance-admin"
+)
+
+func (r Role) Valid() bool {
+	switch r {
+	case RoleEmployee, RoleShopManager, RoleFinanceAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+type claimsKey struct{}
+
+// WithClaims stores the claims of an authenticated request in its context.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+
+	return claims, ok
+}
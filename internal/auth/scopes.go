@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+// Scope is a permission granted to an API key. Service accounts have no role,
+// they can only call the routes their key has a scope for.
+type Scope string
+
+const (
+	ScopeCoinsGrant   Scope = "coins:grant"
+	ScopeCatalogWrite Scope = "catalog:write"
+	ScopeReadReports  Scope = "read:reports"
+)
+
+func (s Scope) Valid() bool {
+	switch s {
+	case ScopeCoinsGrant, ScopeCatalogWrite, ScopeReadReports:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidAPIKey is returned for API keys that are unknown, expired or revoked.
+var ErrInvalidAPIKey = errors.New("api key is invalid, expired or revoked")
+
+// ServiceAccount is the principal of a request authenticated with an API key.
+type ServiceAccount struct {
+	Name      string
+	KeyPrefix string
+	Scopes    []Scope
+}
+
+func (a *ServiceAccount) HasScope(scope Scope) bool {
+	for _, s := range a.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+type serviceAccountKey struct{}
+
+// WithServiceAccount stores the service account of an API key request in its context.
+func WithServiceAccount(ctx context.Context, account *ServiceAccount) context.Context {
+	return context.WithValue(ctx, serviceAccountKey{}, account)
+}
+
+func ServiceAccountFromContext(ctx context.Context) (*ServiceAccount, bool) {
+	account, ok := ctx.Value(serviceAccountKey{}).(*ServiceAccount)
+
+	return account, ok
+}
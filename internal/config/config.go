@@ -1,38 +1,287 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+// Config is the service configuration. Sections tagged reload:"true" are applied to the running
+// service when the file changes, see Watcher; values tagged secret:"true" are never shown.
 type Config struct {
-	Server struct {
+	Server Server `yaml:"server"`
+
+	// Admin serves operational endpoints such as /metrics on a port of its own, without
+	// authentication, so it must only be reachable from the internal network. Port 0 disables it.
+	Admin struct {
 		Host string `yaml:"host"`
 		Port int    `yaml:"port"`
-	} `yaml:"server"`
+	} `yaml:"admin"`
 
 	Database struct {
-		DSN        string `yaml:"dsn"`
+		DSN        string `yaml:"dsn" secret:"true"`
 		Migrations string `yaml:"migrations"`
 	} `yaml:"database"`
 
-	Log struct {
-		Level  string `yaml:"level"`
-		Output string `yaml:"output"`
-	} `yaml:"log"`
+	Auth struct {
+		// SigningKeyID is the kid of the key that signs new tokens.
+		// Every other key in Keys only verifies tokens it has already signed.
+		SigningKeyID string       `yaml:"signingKeyId"`
+		Keys         []SigningKey `yaml:"keys"`
+
+		DenylistRefresh time.Duration `yaml:"denylistRefresh"`
+
+		Lockout struct {
+			Username LockoutPolicy `yaml:"username"`
+			IP       LockoutPolicy `yaml:"ip"`
+		} `yaml:"lockout" reload:"true"`
+
+		Password PasswordPolicy `yaml:"password"`
+
+		MFA struct {
+			// Issuer is shown next to the account name in authenticator apps.
+			Issuer string `yaml:"issuer"`
+			// TransferThreshold makes transfers of more coins require a one-time code. Zero disables the check.
+			TransferThreshold int `yaml:"transferThreshold"`
+		} `yaml:"mfa" reload:"true"`
+
+		// OIDC enables single sign-on through the corporate identity provider.
+		OIDC OIDCProvider `yaml:"oidc"`
+
+		Registration struct {
+			// Mode is one of RegistrationOpen, RegistrationInvite, RegistrationAdmin or RegistrationImplicit.
+			Mode      string        `yaml:"mode"`
+			InviteTTL time.Duration `yaml:"inviteTTL"`
+		} `yaml:"registration" reload:"true"`
+	} `yaml:"auth"`
+
+	RateLimit RateLimits `yaml:"rateLimit" reload:"true"`
+
+	Idempotency struct {
+		// TTL is how long the response to a request with an Idempotency-Key is replayed.
+		TTL time.Duration `yaml:"ttl"`
+	} `yaml:"idempotency"`
+
+	// Validation checks requests against the OpenAPI spec. Responses also checks the responses
+	// of the handlers; it buffers every response, so it is a debug mode for development.
+	Validation struct {
+		Requests  bool `yaml:"requests"`
+		Responses bool `yaml:"responses"`
+	} `yaml:"validation"`
+
+	// Docs serves the OpenAPI document and Swagger UI to anyone who can reach the server, without
+	// authentication. It is off unless enabled, so production does not describe the API to the public.
+	Docs struct {
+		Enabled bool `yaml:"enabled"`
+	} `yaml:"docs"`
+
+	Log Log `yaml:"log" reload:"true"`
+
+	Tracing Tracing `yaml:"tracing"`
+}
+
+const (
+	// RegistrationOpen lets anyone sign up through POST /api/register.
+	RegistrationOpen = "open"
+	// RegistrationInvite requires an invite code issued by a finance-admin.
+	RegistrationInvite = "invite"
+	// RegistrationAdmin disables self-signup, employees are provisioned by a finance-admin.
+	RegistrationAdmin = "admin"
+	// RegistrationImplicit is the assignment-compatible mode: POST /api/auth
+	// creates an employee for every unknown username, and self-signup is open.
+	RegistrationImplicit = "implicit"
+)
+
+// Server configures the public HTTP listener. Unset timeouts and limits fall back to the
+// defaults below. When CertFile and KeyFile are set the server speaks TLS and HTTP/2.
+type Server struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout"`
+	ReadTimeout       time.Duration `yaml:"readTimeout"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout"`
+	MaxHeaderBytes    int           `yaml:"maxHeaderBytes"`
+
+	// DrainDelay is how long /readyz fails before the server stops accepting
+	// connections, giving load balancers time to take the instance out of rotation.
+	DrainDelay time.Duration `yaml:"drainDelay"`
+	// ShutdownTimeout bounds how long in-flight requests may run once shutdown starts.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+
+	TLS struct {
+		CertFile string `yaml:"certFile"`
+		KeyFile  string `yaml:"keyFile"`
+	} `yaml:"tls"`
+}
+
+// TLSEnabled reports whether the server is configured to serve HTTPS.
+func (s Server) TLSEnabled() bool {
+	return s.TLS.CertFile != "" && s.TLS.KeyFile != ""
+}
+
+const (
+	DefaultPort              = 8080
+	DefaultReadHeaderTimeout = 5 * time.Second
+	DefaultReadTimeout       = 15 * time.Second
+	DefaultWriteTimeout      = 30 * time.Second
+	DefaultIdleTimeout       = 2 * time.Minute
+	DefaultMaxHeaderBytes    = 1 << 20
+	DefaultShutdownTimeout   = 10 * time.Second
+
+	DefaultIdempotencyTTL = 24 * time.Hour
+)
+
+// RateLimit allows a client Requests per Period on average, in bursts of up to Burst
+// (Requests when unset). A zero limit disables throttling.
+type RateLimit struct {
+	Requests int           `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int           `yaml:"burst"`
+}
+
+// RateLimits are keyed by route as in the access policy ("POST /api/buy/{item}");
+// routes missing from Routes get Default. Clients are told apart by username, service
+// account or, before they log in, by IP address.
+type RateLimits struct {
+	Default RateLimit            `yaml:"default"`
+	Routes  map[string]RateLimit `yaml:"routes"`
+}
+
+// LockoutPolicy throttles failed logins for one key (a username or a client IP).
+// After FreeAttempts consecutive failures every next attempt has to wait BaseDelay,
+// doubling up to MaxDelay; LockoutThreshold failures lock the key for LockoutDuration.
+// Failures older than ResetAfter are forgotten. A zero policy disables throttling.
+type LockoutPolicy struct {
+	FreeAttempts     int           `yaml:"freeAttempts"`
+	BaseDelay        time.Duration `yaml:"baseDelay"`
+	MaxDelay         time.Duration `yaml:"maxDelay"`
+	LockoutThreshold int           `yaml:"lockoutThreshold"`
+	LockoutDuration  time.Duration `yaml:"lockoutDuration"`
+	ResetAfter       time.Duration `yaml:"resetAfter"`
 }
 
+// PasswordPolicy is enforced whenever a password is set. Passwords shorter than MinLength
+// or listed in CommonPasswordsFile (one per line; the list bundled with the service when empty)
+// are rejected. Hashes below BcryptCost are upgraded on the next successful login.
+// ResetTTL is how long an admin-issued reset token stays valid.
+type PasswordPolicy struct {
+	MinLength           int           `yaml:"minLength"`
+	CommonPasswordsFile string        `yaml:"commonPasswordsFile"`
+	BcryptCost          int           `yaml:"bcryptCost"`
+	ResetTTL            time.Duration `yaml:"resetTTL"`
+}
+
+// OIDCProvider is an OpenID Connect issuer employees can log in with (authorization code flow with PKCE).
+// An empty Issuer disables single sign-on. UsernameClaim names the ID token claim matched against
+// employees on the first login ("preferred_username" by default); later logins are matched by the subject.
+type OIDCProvider struct {
+	Issuer        string        `yaml:"issuer"`
+	ClientID      string        `yaml:"clientId"`
+	ClientSecret  string        `yaml:"clientSecret" secret:"true"`
+	RedirectURL   string        `yaml:"redirectUrl"`
+	Scopes        []string      `yaml:"scopes"`
+	UsernameClaim string        `yaml:"usernameClaim"`
+	LoginTTL      time.Duration `yaml:"loginTTL"`
+}
+
+// Log configures logging. Output is "stdout", "stderr" (the default) or a file path;
+// files are rotated by size and age. Packages overrides Level for single packages
+// ("service", "db", "middleware", "access", ...).
+type Log struct {
+	Level    string            `yaml:"level"`
+	Format   string            `yaml:"format"`
+	Output   string            `yaml:"output"`
+	Rotation LogRotation       `yaml:"rotation"`
+	Packages map[string]string `yaml:"packages"`
+}
+
+// LogRotation starts a new log file once the current one reaches MaxSizeMB and removes
+// rotated files older than MaxAgeDays or beyond the MaxBackups newest. Zero keeps them all.
+type LogRotation struct {
+	MaxSizeMB  int  `yaml:"maxSizeMB"`
+	MaxAgeDays int  `yaml:"maxAgeDays"`
+	MaxBackups int  `yaml:"maxBackups"`
+	Compress   bool `yaml:"compress"`
+}
+
+// Tracing configures OpenTelemetry tracing. Exporter is "otlp" (OTLP over HTTP to Endpoint,
+// or to the OTEL_EXPORTER_OTLP_* environment settings when it is empty), "stdout", "file"
+// (spans as JSON lines in File) or "" to disable tracing. SampleRatio is the share of new
+// traces recorded, 1 when unset; traces started upstream follow the caller's decision.
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint"`
+	File        string  `yaml:"file"`
+	ServiceName string  `yaml:"serviceName"`
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// SigningKey describes a JWT key. HS256 keys use Secret, RS256 and EdDSA keys are read
+// from PEM files; a key with only PublicKeyFile set can verify tokens but not sign them.
+type SigningKey struct {
+	ID             string `yaml:"id"`
+	Algorithm      string `yaml:"algorithm"`
+	Secret         string `yaml:"secret" secret:"true"`
+	PrivateKeyFile string `yaml:"privateKeyFile"`
+	PublicKeyFile  string `yaml:"publicKeyFile"`
+}
+
+// Init loads the YAML file at path with environment overrides, see Load.
 func Init(path string) (*Config, error) {
+	return Load(path, nil)
+}
+
+// Load builds the configuration in layers, each overriding the previous one: Defaults,
+// the YAML file at path, MERCH_* environment variables and overrides, keyed by the dotted
+// YAML path ("server.port"). The result is validated as a whole.
+func Load(path string, overrides Overrides) (*Config, error) {
+	c := Defaults()
+
 	yml, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	c := &Config{}
 	if err = yaml.Unmarshal(yml, c); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	if err := applyEnv(c, os.LookupEnv); err != nil {
+		return nil, err
+	}
+
+	if err := applyOverrides(c, overrides); err != nil {
+		return nil, err
+	}
+
+	if err := c.Validate(); err != nil {
 		return nil, err
 	}
 
 	return c, nil
 }
+
+// Defaults returns the configuration used for everything the YAML file leaves out.
+func Defaults() *Config {
+	c := &Config{}
+
+	c.Server.Port = DefaultPort
+	c.Server.ReadHeaderTimeout = DefaultReadHeaderTimeout
+	c.Server.ReadTimeout = DefaultReadTimeout
+	c.Server.WriteTimeout = DefaultWriteTimeout
+	c.Server.IdleTimeout = DefaultIdleTimeout
+	c.Server.MaxHeaderBytes = DefaultMaxHeaderBytes
+	c.Server.ShutdownTimeout = DefaultShutdownTimeout
+
+	c.Auth.Registration.Mode = RegistrationOpen
+
+	c.Idempotency.TTL = DefaultIdempotencyTTL
+
+	c.Validation.Requests = true
+
+	return c
+}
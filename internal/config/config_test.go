@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// base is the smallest configuration that passes validation.
+const base = `
+database:
+  dsn: host=localhost dbname=merch-shop
+  migrations: ./migrations
+auth:
+  signingKeyId: k1
+  keys:
+    - id: k1
+      algorithm: HS256
+      secret: yaml-secret
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func writeConfig(t *testing.T, yml string) string {
+	t.Helper()
+
+	return writeFile(t, "config.yaml", base+yml)
+}
+
+func TestServerDefaults(t *testing.T) {
+	cfg, err := Init(writeConfig(t, "server:\n  host: 127.0.0.1\n  writeTimeout: 1m\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "127.0.0.1", cfg.Server.Host)
+	assert.Equal(t, DefaultPort, cfg.Server.Port)
+	assert.Equal(t, DefaultReadHeaderTimeout, cfg.Server.ReadHeaderTimeout)
+	assert.Equal(t, DefaultReadTimeout, cfg.Server.ReadTimeout)
+	assert.Equal(t, time.Minute, cfg.Server.WriteTimeout)
+	assert.Equal(t, DefaultIdleTimeout, cfg.Server.IdleTimeout)
+	assert.Equal(t, DefaultMaxHeaderBytes, cfg.Server.MaxHeaderBytes)
+	assert.Equal(t, DefaultShutdownTimeout, cfg.Server.ShutdownTimeout)
+	assert.Equal(t, RegistrationOpen, cfg.Auth.Registration.Mode)
+	assert.False(t, cfg.Server.TLSEnabled())
+}
+
+func TestServerTLS(t *testing.T) {
+	cert := writeFile(t, "tls.crt", "cert")
+	key := writeFile(t, "tls.key", "key")
+
+	cfg, err := Init(writeConfig(t, "server:\n  tls:\n    certFile: "+cert+"\n    keyFile: "+key+"\n"))
+	require.NoError(t, err)
+	assert.True(t, cfg.Server.TLSEnabled())
+
+	_, err = Init(writeConfig(t, "server:\n  tls:\n    certFile: "+cert+"\n"))
+	assert.ErrorContains(t, err, "server.tls: certFile and keyFile must be set together")
+}
+
+func TestDevConfig(t *testing.T) {
+	cfg, err := Init("../../config.dev.yaml")
+	require.NoError(t, err)
+
+	assert.Equal(t, 8080, cfg.Server.Port)
+	assert.Equal(t, 5*time.Second, cfg.Server.DrainDelay)
+	assert.False(t, cfg.Docs.Enabled, "the image config does not serve the docs")
+	assert.False(t, cfg.Validation.Responses, "the image config does not buffer responses")
+	assert.Equal(t, "info", cfg.Log.Level)
+	assert.Equal(t, RegistrationOpen, cfg.Auth.Registration.Mode)
+}
+
+func TestEnvOverrides(t *testing.T) {
+	t.Setenv("MERCH_DATABASE_DSN", "host=postgres dbname=merch-shop")
+	t.Setenv("MERCH_SERVER_PORT", "9000")
+	t.Setenv("MERCH_AUTH_REGISTRATION_INVITE_TTL", "48h")
+	t.Setenv("MERCH_AUTH_OIDC_SCOPES", "email, profile")
+	t.Setenv("MERCH_AUTH_KEYS_0_SECRET_FILE", writeFile(t, "secret", "file-secret\n"))
+	t.Setenv("MERCH_DOCS_ENABLED", "true")
+
+	cfg, err := Init(writeConfig(t, ""))
+	require.NoError(t, err)
+
+	assert.Equal(t, "host=postgres dbname=merch-shop", cfg.Database.DSN)
+	assert.Equal(t, 9000, cfg.Server.Port)
+	assert.Equal(t, 48*time.Hour, cfg.Auth.Registration.InviteTTL)
+	assert.Equal(t, []string{"email", "profile"}, cfg.Auth.OIDC.Scopes)
+	assert.Equal(t, "file-secret", cfg.Auth.Keys[0].Secret)
+	assert.True(t, cfg.Docs.Enabled, "the docs are off by default")
+}
+
+func TestEnvErrors(t *testing.T) {
+	t.Setenv("MERCH_SERVER_PORT", "http")
+	t.Setenv("MERCH_DATABASE_DSN", "host=postgres")
+	t.Setenv("MERCH_DATABASE_DSN_FILE", "/run/secrets/dsn")
+
+	_, err := Init(writeConfig(t, ""))
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "MERCH_SERVER_PORT")
+	assert.ErrorContains(t, err, "MERCH_DATABASE_DSN and MERCH_DATABASE_DSN_FILE are both set")
+}
+
+func TestOverridesWinOverEnv(t *testing.T) {
+	t.Setenv("MERCH_SERVER_PORT", "9000")
+
+	overrides := Overrides{}
+	require.NoError(t, overrides.Set("server.port=9100"))
+	require.NoError(t, overrides.Set("log.level=warn"))
+	assert.Error(t, overrides.Set("server.port"))
+
+	cfg, err := Load(writeConfig(t, ""), overrides)
+	require.NoError(t, err)
+	assert.Equal(t, 9100, cfg.Server.Port)
+	assert.Equal(t, "warn", cfg.Log.Level)
+
+	_, err = Load(writeConfig(t, ""), Overrides{"server.prot": "1"})
+	assert.ErrorContains(t, err, `unknown configuration key "server.prot"`)
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	_, err := Init(writeFile(t, "config.yaml", `
+server:
+  port: 70000
+auth:
+  signingKeyId: missing
+  keys:
+    - id: k1
+  registration:
+    mode: closed
+validation:
+  requests: false
+  responses: true
+tracing:
+  exporter: file
+`))
+	require.Error(t, err)
+
+	for _, msg := range []string{
+		"server.port: must be between 1 and 65535, got 70000",
+		"database.dsn: is required",
+		"database.migrations: is required",
+		"auth.keys.0: needs a secret, privateKeyFile or publicKeyFile",
+		`auth.signingKeyId: no key with id "missing" in auth.keys`,
+		`auth.registration.mode: unknown mode "closed"`,
+		"validation.responses: needs validation.requests",
+		"tracing.file: is required for the file exporter",
+	} {
+		assert.ErrorContains(t, err, msg)
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "MERCH_DATABASE_DSN", envName("database.dsn"))
+	assert.Equal(t, "MERCH_AUTH_OIDC_CLIENT_SECRET", envName("auth.oidc.clientSecret"))
+	assert.Equal(t, "MERCH_LOG_ROTATION_MAX_SIZE_MB", envName("log.rotation.maxSizeMB"))
+	assert.Equal(t, "MERCH_AUTH_KEYS_0_PRIVATE_KEY_FILE", envName("auth.keys.0.privateKeyFile"))
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// EnvPrefix starts the names of the environment variables that override the configuration.
+// The rest of the name is the YAML path in upper snake case: database.dsn is MERCH_DATABASE_DSN,
+// auth.oidc.clientSecret is MERCH_AUTH_OIDC_CLIENT_SECRET and the secret of the first signing
+// key is MERCH_AUTH_KEYS_0_SECRET. Lists of strings are comma-separated.
+const EnvPrefix = "MERCH_"
+
+// FileSuffix turns a variable into the path of a file holding the value, so secrets can be
+// mounted by the orchestrator instead of being passed in the environment.
+const FileSuffix = "_FILE"
+
+// Overrides are configuration values keyed by their dotted YAML path, such as "server.port"
+// or "auth.keys.0.secret". It is a flag.Value accepting key=value, so the flag can be repeated.
+type Overrides map[string]string
+
+func (o Overrides) String() string {
+	keys := make([]string, 0, len(o))
+	for key, value := range o {
+		keys = append(keys, key+"="+value)
+	}
+	sort.Strings(keys)
+
+	return strings.Join(keys, ",")
+}
+
+func (o Overrides) Set(s string) error {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", s)
+	}
+
+	o[key] = value
+
+	return nil
+}
+
+// field is a configuration value that can be overridden.
+type field struct {
+	path  string
+	value reflect.Value
+}
+
+// fields lists the overridable values of v by their YAML path. Elements of lists of
+// structs are addressed by index and only exist when the YAML file defines them; maps
+// can only be set in the file.
+func fields(v reflect.Value, prefix string) []field {
+	var out []field
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := yamlName(t.Field(i))
+		if name == "" {
+			continue
+		}
+
+		path := join(prefix, name)
+
+		fv := v.Field(i)
+		switch {
+		case fv.Kind() == reflect.Struct:
+			out = append(out, fields(fv, path)...)
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Struct:
+			for j := 0; j < fv.Len(); j++ {
+				out = append(out, fields(fv.Index(j), path+"."+strconv.Itoa(j))...)
+			}
+		case fv.Kind() == reflect.Map:
+		default:
+			out = append(out, field{path: path, value: fv})
+		}
+	}
+
+	return out
+}
+
+// envName maps a YAML path to its environment variable, e.g. auth.registration.inviteTTL
+// to MERCH_AUTH_REGISTRATION_INVITE_TTL.
+func envName(path string) string {
+	var b strings.Builder
+	b.WriteString(EnvPrefix)
+
+	var prev rune
+	for _, r := range path {
+		switch {
+		case r == '.':
+			b.WriteByte('_')
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			b.WriteByte('_')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(unicode.ToUpper(r))
+		}
+		prev = r
+	}
+
+	return b.String()
+}
+
+// applyEnv sets every value that has an environment variable, or a *_FILE variable, defined.
+func applyEnv(c *Config, lookup func(string) (string, bool)) error {
+	var errs []error
+
+	for _, f := range fields(reflect.ValueOf(c).Elem(), "") {
+		name := envName(f.path)
+
+		value, ok := lookup(name)
+		file, fromFile := lookup(name + FileSuffix)
+		if ok && fromFile {
+			errs = append(errs, fmt.Errorf("%s and %s%s are both set", name, name, FileSuffix))
+
+			continue
+		}
+
+		if fromFile {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", name, FileSuffix, err))
+
+				continue
+			}
+
+			value, ok = strings.TrimRight(string(content), "\r\n"), true
+		}
+
+		if !ok {
+			continue
+		}
+
+		if err := setValue(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func applyOverrides(c *Config, overrides Overrides) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	byPath := map[string]reflect.Value{}
+	for _, f := range fields(reflect.ValueOf(c).Elem(), "") {
+		byPath[f.path] = f.value
+	}
+
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		v, ok := byPath[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown configuration key %q", key))
+
+			continue
+		}
+
+		if err := setValue(v, overrides[key]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setValue(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.CanInt():
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.CanFloat():
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// WatchInterval is how often the Watcher checks the configuration file for changes.
+const WatchInterval = 5 * time.Second
+
+// Redacted replaces secret values in the effective configuration.
+const Redacted = "[REDACTED]"
+
+// Watcher keeps the effective configuration and reloads it when the file changes or the
+// process receives SIGHUP. Only sections tagged reload:"true" are taken over from the new
+// file; a change anywhere else is logged and ignored until the next restart.
+type Watcher struct {
+	path      string
+	overrides Overrides
+	current   atomic.Pointer[Config]
+
+	mu       sync.Mutex
+	checksum [sha256.Size]byte
+	handlers []func(*Config)
+}
+
+// NewWatcher starts from cfg, the configuration Load returned for path and overrides.
+func NewWatcher(path string, overrides Overrides, cfg *Config) *Watcher {
+	w := &Watcher{path: path, overrides: overrides}
+	w.current.Store(cfg)
+
+	if yml, err := os.ReadFile(path); err == nil {
+		w.checksum = sha256.Sum256(yml)
+	}
+
+	return w
+}
+
+// Current returns the effective configuration. It must not be modified.
+func (w *Watcher) Current() *Config {
+	return w.current.Load()
+}
+
+// OnReload registers fn to be called with the new effective configuration after every reload
+// that changed a reloadable section.
+func (w *Watcher) OnReload(fn func(*Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.handlers = append(w.handlers, fn)
+}
+
+// Reload loads and validates the file again and applies its reloadable sections. An invalid
+// file changes nothing.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	yml, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	w.checksum = sha256.Sum256(yml)
+
+	loaded, err := Load(w.path, w.overrides)
+	if err != nil {
+		return err
+	}
+
+	old := w.Current()
+	next := *old
+	applied, rejected := merge(reflect.ValueOf(&next).Elem(), reflect.ValueOf(loaded).Elem(), "")
+
+	for _, path := range rejected {
+		log.WithField("field", path).Warn("configuration change needs a restart, keeping the running value")
+	}
+
+	if len(applied) == 0 {
+		return nil
+	}
+
+	w.current.Store(&next)
+	for _, fn := range w.handlers {
+		fn(&next)
+	}
+
+	log.WithField("sections", applied).Info("configuration reloaded")
+
+	return nil
+}
+
+// Run reloads on SIGHUP and whenever the content of the file changes, until ctx is done.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		case <-ticker.C:
+			if !w.changed() {
+				continue
+			}
+		}
+
+		if err := w.Reload(); err != nil {
+			log.Error(fmt.Errorf("error reloading configuration: %w", err))
+		}
+	}
+}
+
+func (w *Watcher) changed() bool {
+	yml, err := os.ReadFile(w.path)
+	if err != nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sum := sha256.Sum256(yml)
+
+	return !bytes.Equal(sum[:], w.checksum[:])
+}
+
+// Handler serves the effective configuration as YAML with the secrets redacted.
+func (w *Watcher) Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		yml, err := yaml.Marshal(w.Current().Redact())
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/yaml")
+		rw.Header().Set("Cache-Control", "no-store")
+		_, _ = rw.Write(yml)
+	})
+}
+
+// merge copies the reloadable fields of src into dst and returns the YAML paths of the
+// reloadable sections that changed and of the other fields that differ.
+func merge(dst, src reflect.Value, prefix string) (applied, rejected []string) {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := yamlName(t.Field(i))
+		if name == "" {
+			continue
+		}
+
+		path := join(prefix, name)
+		df, sf := dst.Field(i), src.Field(i)
+
+		switch {
+		case reflect.DeepEqual(df.Interface(), sf.Interface()):
+		case t.Field(i).Tag.Get("reload") == "true":
+			df.Set(sf)
+			applied = append(applied, path)
+		case df.Kind() == reflect.Struct:
+			a, r := merge(df, sf, path)
+			applied, rejected = append(applied, a...), append(rejected, r...)
+		default:
+			rejected = append(rejected, path)
+		}
+	}
+
+	return applied, rejected
+}
+
+// Redact returns a copy of c with every field tagged secret:"true" replaced by Redacted.
+func (c *Config) Redact() *Config {
+	redacted := *c
+	redact(reflect.ValueOf(&redacted).Elem())
+
+	return &redacted
+}
+
+func redact(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := v.Field(i)
+
+		switch {
+		case t.Field(i).Tag.Get("secret") == "true":
+			if f.Kind() == reflect.String && f.String() != "" {
+				f.SetString(Redacted)
+			}
+		case f.Kind() == reflect.Struct:
+			redact(f)
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.Struct:
+			// copy the elements, the slice is shared with the effective configuration
+			items := reflect.MakeSlice(f.Type(), f.Len(), f.Len())
+			reflect.Copy(items, f)
+			for j := 0; j < items.Len(); j++ {
+				redact(items.Index(j))
+			}
+			f.Set(items)
+		}
+	}
+}
+
+// yamlName returns the key of a field in the YAML file, empty for fields the file cannot set.
+func yamlName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	path := writeConfig(t, "log:\n  level: info\n")
+
+	cfg, err := Load(path, nil)
+	require.NoError(t, err)
+
+	w := NewWatcher(path, nil, cfg)
+
+	var reloaded *Config
+	w.OnReload(func(c *Config) { reloaded = c })
+
+	t.Run("reloadable section", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(base+"log:\n  level: debug\n"), 0o600))
+		assert.True(t, w.changed())
+		require.NoError(t, w.Reload())
+
+		require.NotNil(t, reloaded)
+		assert.Equal(t, "debug", reloaded.Log.Level)
+		assert.Same(t, reloaded, w.Current())
+		assert.Equal(t, "info", cfg.Log.Level, "the previous configuration is left intact")
+		assert.False(t, w.changed())
+	})
+
+	t.Run("restart needed", func(t *testing.T) {
+		reloaded = nil
+		yml := strings.Replace(base, "host=localhost", "host=elsewhere", 1) + "log:\n  level: warn\n"
+		require.NoError(t, os.WriteFile(path, []byte(yml), 0o600))
+		require.NoError(t, w.Reload())
+
+		require.NotNil(t, reloaded)
+		assert.Equal(t, "warn", w.Current().Log.Level)
+		assert.Equal(t, "host=localhost dbname=merch-shop", w.Current().Database.DSN)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		reloaded = nil
+		require.NoError(t, os.WriteFile(path, []byte(base+"log:\n  level: loud\n"), 0o600))
+		assert.ErrorContains(t, w.Reload(), "log.level")
+
+		assert.Nil(t, reloaded)
+		assert.Equal(t, "warn", w.Current().Log.Level)
+	})
+}
+
+func TestRedact(t *testing.T) {
+	cfg, err := Init(writeConfig(t, ""))
+	require.NoError(t, err)
+
+	redacted := cfg.Redact()
+	assert.Equal(t, Redacted, redacted.Database.DSN)
+	assert.Equal(t, Redacted, redacted.Auth.Keys[0].Secret)
+	assert.Empty(t, redacted.Auth.OIDC.ClientSecret)
+	assert.Equal(t, "k1", redacted.Auth.Keys[0].ID)
+	assert.Equal(t, "yaml-secret", cfg.Auth.Keys[0].Secret)
+
+	rec := httptest.NewRecorder()
+	NewWatcher("", nil, cfg).Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/config", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "signingKeyId: k1")
+	assert.Contains(t, rec.Body.String(), "readHeaderTimeout: 5s")
+	assert.NotContains(t, rec.Body.String(), "yaml-secret")
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Log formats, see Log.
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+// Tracing exporters, see Tracing.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Validate reports every invalid or missing value at once, each prefixed with its YAML path.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(path, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		fail("server.port", "must be between 1 and 65535, got %d", c.Server.Port)
+	}
+	if c.Server.ReadHeaderTimeout <= 0 {
+		fail("server.readHeaderTimeout", "must be positive")
+	}
+	for _, d := range []struct {
+		path  string
+		value time.Duration
+	}{
+		{"server.readTimeout", c.Server.ReadTimeout},
+		{"server.writeTimeout", c.Server.WriteTimeout},
+		{"server.idleTimeout", c.Server.IdleTimeout},
+		{"server.drainDelay", c.Server.DrainDelay},
+	} {
+		if d.value < 0 {
+			fail(d.path, "must not be negative")
+		}
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		fail("server.shutdownTimeout", "must be positive")
+	}
+	if c.Server.MaxHeaderBytes <= 0 {
+		fail("server.maxHeaderBytes", "must be positive")
+	}
+	if (c.Server.TLS.CertFile == "") != (c.Server.TLS.KeyFile == "") {
+		fail("server.tls", "certFile and keyFile must be set together")
+	}
+	for _, file := range []string{c.Server.TLS.CertFile, c.Server.TLS.KeyFile} {
+		if _, err := os.Stat(file); file != "" && err != nil {
+			fail("server.tls", "%v", err)
+		}
+	}
+
+	if c.Admin.Port < 0 || c.Admin.Port > 65535 {
+		fail("admin.port", "must be between 0 and 65535, got %d", c.Admin.Port)
+	}
+
+	if c.Database.DSN == "" {
+		fail("database.dsn", "is required")
+	}
+	if c.Database.Migrations == "" {
+		fail("database.migrations", "is required")
+	}
+
+	c.validateKeys(fail)
+
+	if c.Auth.DenylistRefresh < 0 {
+		fail("auth.denylistRefresh", "must not be negative")
+	}
+
+	switch c.Auth.Registration.Mode {
+	case RegistrationOpen, RegistrationInvite, RegistrationAdmin, RegistrationImplicit:
+	default:
+		fail("auth.registration.mode", "unknown mode %q", c.Auth.Registration.Mode)
+	}
+
+	if c.Auth.OIDC.Issuer != "" {
+		if c.Auth.OIDC.ClientID == "" {
+			fail("auth.oidc.clientId", "is required when the issuer is set")
+		}
+		if c.Auth.OIDC.RedirectURL == "" {
+			fail("auth.oidc.redirectUrl", "is required when the issuer is set")
+		}
+	}
+
+	validateRateLimit("rateLimit.default", c.RateLimit.Default, fail)
+	routes := make([]string, 0, len(c.RateLimit.Routes))
+	for route := range c.RateLimit.Routes {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	for _, route := range routes {
+		validateRateLimit("rateLimit.routes."+route, c.RateLimit.Routes[route], fail)
+	}
+
+	if c.Idempotency.TTL <= 0 {
+		fail("idempotency.ttl", "must be positive")
+	}
+
+	if c.Validation.Responses && !c.Validation.Requests {
+		fail("validation.responses", "needs validation.requests")
+	}
+
+	c.validateLog(fail)
+
+	switch c.Tracing.Exporter {
+	case "", ExporterOTLP, ExporterStdout:
+	case ExporterFile:
+		if c.Tracing.File == "" {
+			fail("tracing.file", "is required for the file exporter")
+		}
+	default:
+		fail("tracing.exporter", "unknown exporter %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sampleRatio", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateKeys(fail func(path, format string, args ...any)) {
+	if len(c.Auth.Keys) == 0 {
+		fail("auth.keys", "at least one signing key is required")
+	}
+
+	ids := map[string]bool{}
+	for i, key := range c.Auth.Keys {
+		path := fmt.Sprintf("auth.keys.%d", i)
+
+		switch {
+		case key.ID == "":
+			fail(path+".id", "is required")
+		case ids[key.ID]:
+			fail(path+".id", "duplicate key id %q", key.ID)
+		}
+		ids[key.ID] = true
+
+		if key.Secret == "" && key.PrivateKeyFile == "" && key.PublicKeyFile == "" {
+			fail(path, "needs a secret, privateKeyFile or publicKeyFile")
+		}
+	}
+
+	switch {
+	case c.Auth.SigningKeyID == "":
+		fail("auth.signingKeyId", "is required")
+	case !ids[c.Auth.SigningKeyID]:
+		fail("auth.signingKeyId", "no key with id %q in auth.keys", c.Auth.SigningKeyID)
+	}
+}
+
+func (c *Config) validateLog(fail func(path, format string, args ...any)) {
+	if c.Log.Level != "" {
+		if _, err := log.ParseLevel(c.Log.Level); err != nil {
+			fail("log.level", "%v", err)
+		}
+	}
+
+	pkgs := make([]string, 0, len(c.Log.Packages))
+	for pkg := range c.Log.Packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	for _, pkg := range pkgs {
+		if _, err := log.ParseLevel(c.Log.Packages[pkg]); err != nil {
+			fail("log.packages."+pkg, "%v", err)
+		}
+	}
+
+	switch c.Log.Format {
+	case "", LogFormatJSON, LogFormatText:
+	default:
+		fail("log.format", "unknown format %q", c.Log.Format)
+	}
+}
+
+func validateRateLimit(path string, limit RateLimit, fail func(path, format string, args ...any)) {
+	if limit.Requests < 0 || limit.Burst < 0 {
+		fail(path, "requests and burst must not be negative")
+	}
+	if limit.Requests > 0 && limit.Period <= 0 {
+		fail(path+".period", "must be positive")
+	}
+}
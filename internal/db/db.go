@@ -7,14 +7,28 @@ import (
 	"time"
 
 	"github.com/basedalex/merch-shop/internal/config"
+	"github.com/basedalex/merch-shop/internal/idempotency"
+	"github.com/basedalex/merch-shop/internal/logging"
+	"github.com/basedalex/merch-shop/internal/metrics"
+	"github.com/basedalex/merch-shop/internal/password"
 	api "github.com/basedalex/merch-shop/internal/swagger"
+	"github.com/basedalex/merch-shop/internal/tracing"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var logger = logging.Logger("db")
+
+// Postgres SQLSTATE codes the repository maps to domain errors.
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+)
+
 //go:generate mockgen -source=db.go -destination=../mocks/mock_db.go -package=mocks
 type Repository interface {
 	GetEmployeeInfo(ctx context.Context, employeeName string) (*InfoResponse, error)
@@ -22,10 +36,54 @@ type Repository interface {
 	BuyItem(ctx context.Context, employeeName, item string) error
 	Authenticate(ctx context.Context, authRequest api.AuthRequest) (bool, error)
 	CreateEmployee(ctx context.Context, authRequest api.AuthRequest) error
+	CreateEmployeeWithRole(ctx context.Context, authRequest api.AuthRequest, role string) error
+	CreateEmployeeWithInvite(ctx context.Context, authRequest api.AuthRequest, inviteHash string) error
+	CreateInvite(ctx context.Context, invite Invite) error
+	CreateRefreshToken(ctx context.Context, token RefreshToken) error
+	RotateRefreshToken(ctx context.Context, oldHash string, next RefreshToken) (string, error)
+	RevokeRefreshTokenFamily(ctx context.Context, hash, username string) error
+	RevokeToken(ctx context.Context, token RevokedToken, username string) error
+	RevokeAllSessions(ctx context.Context, username string) (time.Time, error)
+	ListRevocations(ctx context.Context, since time.Time) (*Revocations, error)
+	GetEmployeeRole(ctx context.Context, username string) (string, error)
+	SetEmployeeRole(ctx context.Context, username, role string) error
+	GetBlockedUntil(ctx context.Context, keys []string) (time.Time, error)
+	RecordAuthFailure(ctx context.Context, key string, resetAfter time.Duration) (int, error)
+	BlockAuthKey(ctx context.Context, key string, until time.Time) error
+	LockAuthKey(ctx context.Context, event LockoutEvent) error
+	ResetAuthFailures(ctx context.Context, key string) error
+	ListLockouts(ctx context.Context) ([]LockoutEvent, error)
+	UnlockAuthKey(ctx context.Context, key, unlockedBy string) error
+	ChangePassword(ctx context.Context, username, passwordHash string) (time.Time, error)
+	CreatePasswordReset(ctx context.Context, reset PasswordReset) error
+	ResetPassword(ctx context.Context, resetHash, passwordHash string) (string, time.Time, error)
+	CreateOIDCLogin(ctx context.Context, login OIDCLogin) error
+	TakeOIDCLogin(ctx context.Context, stateHash string) (*OIDCLogin, error)
+	LinkIdentity(ctx context.Context, identity ExternalIdentity) (string, error)
+	GetMFA(ctx context.Context, username string) (*MFA, error)
+	EnrollMFA(ctx context.Context, username, secret string, recoveryHashes []string) error
+	ConfirmMFA(ctx context.Context, username string, step int64) error
+	UseMFAStep(ctx context.Context, username string, step int64) error
+	UseRecoveryCode(ctx context.Context, username, codeHash string) error
+	DisableMFA(ctx context.Context, username string) error
+	CreateServiceAccount(ctx context.Context, account ServiceAccount) error
+	CreateAPIKey(ctx context.Context, key APIKey) error
+	ListAPIKeys(ctx context.Context, account string) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, account, prefix string) error
+	AuthenticateAPIKey(ctx context.Context, prefix, hash string) (*APIKey, error)
+	GrantCoins(ctx context.Context, grant CoinGrant) error
+	PutItem(ctx context.Context, item string, price int) error
+	ListBalances(ctx context.Context) ([]EmployeeBalance, error)
 }
 
 type Postgres struct {
 	db *pgxpool.Pool
+
+	// bcryptCost is the cost new hashes are made with; weaker hashes are upgraded on login.
+	bcryptCost int
+
+	// schemaVersion is the version of the newest migration this build ships with.
+	schemaVersion int64
 }
 
 func NewPostgres(ctx context.Context, cfg *config.Config) (*Postgres, error) {
@@ -34,6 +92,8 @@ func NewPostgres(ctx context.Context, cfg *config.Config) (*Postgres, error) {
 		return nil, fmt.Errorf("error parsing connection string: %w", err)
 	}
 
+	config.ConnConfig.Tracer = tracing.QueryTracer{}
+
 	db, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to database: %w", err)
@@ -44,29 +104,80 @@ func NewPostgres(ctx context.Context, cfg *config.Config) (*Postgres, error) {
 		return nil, fmt.Errorf("error pinging the database: %w", err)
 	}
 
-	if err := runMigrations(db, cfg.Database.Migrations); err != nil {
+	schemaVersion, err := runMigrations(db, cfg.Database.Migrations)
+	if err != nil {
 		return nil, fmt.Errorf("error running migrations: %w", err)
 	}
 
-	return &Postgres{db: db}, nil
+	return &Postgres{db: db, bcryptCost: password.Cost(cfg.Auth.Password), schemaVersion: schemaVersion}, nil
+}
+
+// Stat returns the connection pool statistics.
+func (p *Postgres) Stat() *pgxpool.Stat {
+	return p.db.Stat()
+}
+
+// CoinSupply returns the coins held by all employees together.
+func (p *Postgres) CoinSupply(ctx context.Context) (int64, error) {
+	var supply int64
+	if err := p.db.QueryRow(ctx, `SELECT COALESCE(SUM(balance), 0) FROM employees`).Scan(&supply); err != nil {
+		return 0, fmt.Errorf("error fetching coin supply: %w", err)
+	}
+
+	return supply, nil
+}
+
+// Ping checks that a connection to the database can be acquired and used.
+func (p *Postgres) Ping(ctx context.Context) error {
+	if err := p.db.Ping(ctx); err != nil {
+		return fmt.Errorf("error pinging the database: %w", err)
+	}
+
+	return nil
+}
+
+// MigrationVersion returns the schema version applied to the database and the
+// version of the newest migration this build ships with.
+func (p *Postgres) MigrationVersion(ctx context.Context) (current, expected int64, err error) {
+	err = p.db.QueryRow(ctx, `
+		SELECT version_id FROM goose_db_version
+		WHERE is_applied
+		ORDER BY id DESC
+		LIMIT 1`).Scan(&current)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error fetching schema version: %w", err)
+	}
+
+	return current, p.schemaVersion, nil
 }
 
-func runMigrations(db *pgxpool.Pool, path string) error {
+// runMigrations applies the pending migrations and returns the version of the newest one.
+func runMigrations(db *pgxpool.Pool, path string) (int64, error) {
 	sqlDB := stdlib.OpenDBFromPool(db)
 
 	if err := goose.SetDialect("postgres"); err != nil {
-		return fmt.Errorf("failed to set dialect: %w", err)
+		return 0, fmt.Errorf("failed to set dialect: %w", err)
+	}
+
+	migrations, err := goose.CollectMigrations(path, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, fmt.Errorf("failed to find the latest migration: %w", err)
 	}
 
 	if err := goose.Up(sqlDB, path); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+		return 0, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
 	if err := sqlDB.Close(); err != nil {
-		return fmt.Errorf("failed to close SQL DB: %w", err)
+		return 0, fmt.Errorf("failed to close SQL DB: %w", err)
 	}
 
-	return nil
+	return last.Version, nil
 }
 
 func (p *Postgres) GetEmployeeInfo(ctx context.Context, employeeName string) (*InfoResponse, error) {
@@ -119,7 +230,6 @@ func (p *Postgres) GetEmployeeInfo(ctx context.Context, employeeName string) (*I
 		})
 	}
 
-
 	query = `SELECT sender, receiver, amount, transaction_date FROM transactions WHERE sender = $1;`
 
 	rows, err = p.db.Query(ctx, query, employeeName)
@@ -148,15 +258,26 @@ func (p *Postgres) GetEmployeeInfo(ctx context.Context, employeeName string) (*I
 }
 
 func (p *Postgres) TransferCoins(ctx context.Context, sender, receiver string, amount int) (err error) {
+	reason := metrics.ReasonError
+	defer func() {
+		if err != nil {
+			metrics.FailedTransfers.WithLabelValues(reason).Inc()
+		}
+	}()
+
 	if amount <= 0 {
-		return fmt.Errorf("invalid transfer amount: %d", amount)
+		reason = metrics.ReasonInvalidAmount
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, amount)
 	}
 	if sender == receiver {
-		return fmt.Errorf("sender and receiver cannot be the same")
+		reason = metrics.ReasonSelfTransfer
+		return ErrSelfTransfer
 	}
 
+	// rows are locked in username order to avoid deadlocks between opposite transfers
+	first, second := sender, receiver
 	if sender > receiver {
-		sender, receiver = receiver, sender
+		first, second = receiver, sender
 		amount = -amount
 	}
 
@@ -168,20 +289,26 @@ func (p *Postgres) TransferCoins(ctx context.Context, sender, receiver string, a
 		_ = tx.Rollback(ctx)
 	}()
 
+	if err = saveIdempotencyRecord(ctx, tx); err != nil {
+		return err
+	}
+
 	var fromBalance int
-	err = tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username=$1 FOR UPDATE;`, sender).Scan(&fromBalance)
+	err = tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username=$1 FOR UPDATE;`, first).Scan(&fromBalance)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("sender not found")
+			reason = metrics.ReasonUnknownEmployee
+			return transferPartyNotFound(first, receiver)
 		}
 		return err
 	}
 
 	var toBalance int
-	err = tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username=$1 FOR UPDATE`, receiver).Scan(&toBalance)
+	err = tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username=$1 FOR UPDATE`, second).Scan(&toBalance)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("sender not found")
+			reason = metrics.ReasonUnknownEmployee
+			return transferPartyNotFound(second, receiver)
 		}
 		return err
 	}
@@ -189,23 +316,23 @@ func (p *Postgres) TransferCoins(ctx context.Context, sender, receiver string, a
 	fromBalance -= amount
 	toBalance += amount
 	if fromBalance < 0 || toBalance < 0 {
-		return fmt.Errorf("not enough money on balance")
+		reason = metrics.ReasonInsufficientFunds
+		return ErrInsufficientFunds
 	}
 
 	query := "UPDATE employees SET balance=$1 WHERE username=$2"
 
-	_, err = tx.Exec(ctx, query, fromBalance, sender)
+	_, err = tx.Exec(ctx, query, fromBalance, first)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.Exec(ctx, query, toBalance, receiver)
+	_, err = tx.Exec(ctx, query, toBalance, second)
 	if err != nil {
 		return err
 	}
 
 	if amount < 0 {
-		sender, receiver = receiver, sender
 		amount = -amount
 	}
 
@@ -215,8 +342,22 @@ func (p *Postgres) TransferCoins(ctx context.Context, sender, receiver string, a
 		return err
 	}
 
-	err = tx.Commit(ctx)
-	return err
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	metrics.CoinsTransferred.Add(float64(amount))
+
+	return nil
+}
+
+// transferPartyNotFound tells a missing receiver from a missing sender.
+func transferPartyNotFound(username, receiver string) error {
+	if username == receiver {
+		return fmt.Errorf("%w: %s", ErrUnknownRecipient, receiver)
+	}
+
+	return ErrEmployeeNotFound
 }
 
 func (p *Postgres) BuyItem(ctx context.Context, employeeName, item string) error {
@@ -229,17 +370,29 @@ func (p *Postgres) BuyItem(ctx context.Context, employeeName, item string) error
 		_ = tx.Rollback(ctx)
 	}()
 
+	if err := saveIdempotencyRecord(ctx, tx); err != nil {
+		return err
+	}
+
 	var price int
-	if err := tx.QueryRow(ctx, `SELECT price FROM merch_shop WHERE product_name = $1`, item).Scan(&price); err != nil {
+	err = tx.QueryRow(ctx, `SELECT price FROM merch_shop WHERE product_name = $1`, item).Scan(&price)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, item)
+	}
+	if err != nil {
 		return fmt.Errorf("error getting item price: %w", err)
 	}
 
 	var balance int
-	if err := tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username = $1 FOR UPDATE`, employeeName).Scan(&balance); err != nil {
+	err = tx.QueryRow(ctx, `SELECT balance FROM employees WHERE username = $1 FOR UPDATE`, employeeName).Scan(&balance)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrEmployeeNotFound
+	}
+	if err != nil {
 		return fmt.Errorf("error fetching balance: %w", err)
 	}
 	if balance < price {
-		return fmt.Errorf("not enough balance")
+		return ErrInsufficientFunds
 	}
 
 	_, err = tx.Exec(ctx, `UPDATE employees SET balance = balance - $1 WHERE username = $2`, price, employeeName)
@@ -257,6 +410,8 @@ func (p *Postgres) BuyItem(ctx context.Context, employeeName, item string) error
 		return fmt.Errorf("error committing transaction: %w", err)
 	}
 
+	metrics.Purchases.WithLabelValues(item).Inc()
+
 	return nil
 }
 
@@ -283,9 +438,35 @@ func (p *Postgres) Authenticate(ctx context.Context, authRequest api.AuthRequest
 		return true, err
 	}
 
+	// the plain password is only known here, so this is the one chance to move the hash to the current cost
+	if password.NeedsRehash(pass, p.bcryptCost) {
+		if err := p.upgradeHash(ctx, tx, authRequest); err != nil {
+			logger.Warn(err)
+
+			return true, nil
+		}
+	}
+
 	return true, nil
 }
 
+func (p *Postgres) upgradeHash(ctx context.Context, tx pgx.Tx, authRequest api.AuthRequest) error {
+	hash, err := password.Hash(authRequest.Password, p.bcryptCost)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE employees SET pass = $1 WHERE username = $2`, hash, authRequest.Username); err != nil {
+		return fmt.Errorf("error upgrading password hash: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Postgres) CreateEmployee(ctx context.Context, authRequest api.AuthRequest) error {
 	tx, err := p.db.Begin(ctx)
 	if err != nil {
@@ -300,12 +481,1039 @@ func (p *Postgres) CreateEmployee(ctx context.Context, authRequest api.AuthReque
 	err = tx.QueryRow(ctx, `INSERT INTO employees (username, pass) VALUES ($1, $2) RETURNING username;`,
 		authRequest.Username, authRequest.Password).Scan(&username)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrEmployeeExists
+		}
+
+		return fmt.Errorf("could not create new user: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) CreateEmployeeWithRole(ctx context.Context, authRequest api.AuthRequest, role string) error {
+	query := `INSERT INTO employees (username, pass, role) VALUES ($1, $2, $3)`
+
+	if _, err := p.db.Exec(ctx, query, authRequest.Username, authRequest.Password, role); err != nil {
+		if isUniqueViolation(err) {
+			return ErrEmployeeExists
+		}
+
+		return fmt.Errorf("could not create new user: %w", err)
+	}
+
+	return nil
+}
+
+// CreateEmployeeWithInvite creates the employee and consumes the invite in one transaction,
+// so an invite code can never be redeemed twice.
+func (p *Postgres) CreateEmployeeWithInvite(ctx context.Context, authRequest api.AuthRequest, inviteHash string) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var valid bool
+	query := `SELECT used_at IS NULL AND expires_at > NOW() FROM invites WHERE code_hash = $1 FOR UPDATE`
+	if err := tx.QueryRow(ctx, query, inviteHash).Scan(&valid); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInviteInvalid
+		}
+
+		return fmt.Errorf("error fetching invite: %w", err)
+	}
+
+	if !valid {
+		return ErrInviteInvalid
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO employees (username, pass) VALUES ($1, $2)`, authRequest.Username, authRequest.Password)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrEmployeeExists
+		}
+
 		return fmt.Errorf("could not create new user: %w", err)
 	}
 
+	_, err = tx.Exec(ctx, `UPDATE invites SET used_by = $1, used_at = NOW() WHERE code_hash = $2`, authRequest.Username, inviteHash)
+	if err != nil {
+		return fmt.Errorf("error redeeming invite: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("error committing transaction: %w", err)
 	}
 
 	return nil
 }
+
+func (p *Postgres) CreateInvite(ctx context.Context, invite Invite) error {
+	query := `INSERT INTO invites (code_hash, created_by, expires_at) VALUES ($1, $2, $3)`
+
+	if _, err := p.db.Exec(ctx, query, invite.Hash, invite.CreatedBy, invite.ExpiresAt); err != nil {
+		return fmt.Errorf("error creating invite: %w", err)
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// CreateRefreshToken stores the first refresh token of a new token family.
+func (p *Postgres) CreateRefreshToken(ctx context.Context, token RefreshToken) error {
+	query := `INSERT INTO refresh_tokens (token_hash, username, expires_at) VALUES ($1, $2, $3)`
+
+	if _, err := p.db.Exec(ctx, query, token.Hash, token.Username, token.ExpiresAt); err != nil {
+		return fmt.Errorf("error storing refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// RotateRefreshToken marks the presented refresh token as used and stores its successor
+// in the same family. Presenting an already used token revokes the whole family,
+// since it means the token has leaked to someone else.
+func (p *Postgres) RotateRefreshToken(ctx context.Context, oldHash string, next RefreshToken) (string, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		username          string
+		expiresAt         time.Time
+		usedAt, revokedAt *time.Time
+	)
+
+	query := `SELECT username, expires_at, used_at, revoked_at FROM refresh_tokens WHERE token_hash = $1 FOR UPDATE`
+	err = tx.QueryRow(ctx, query, oldHash).Scan(&username, &expiresAt, &usedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrRefreshTokenNotFound
+		}
+
+		return "", fmt.Errorf("error fetching refresh token: %w", err)
+	}
+
+	if revokedAt != nil {
+		return "", ErrRefreshTokenRevoked
+	}
+
+	if usedAt != nil {
+		query = `UPDATE refresh_tokens SET revoked_at = NOW()
+			WHERE family_id = (SELECT family_id FROM refresh_tokens WHERE token_hash = $1) AND revoked_at IS NULL`
+		if _, err := tx.Exec(ctx, query, oldHash); err != nil {
+			return "", fmt.Errorf("error revoking refresh token family: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return "", fmt.Errorf("error committing transaction: %w", err)
+		}
+
+		return "", ErrRefreshTokenReused
+	}
+
+	if time.Now().After(expiresAt) {
+		return "", ErrRefreshTokenExpired
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE refresh_tokens SET used_at = NOW() WHERE token_hash = $1`, oldHash); err != nil {
+		return "", fmt.Errorf("error marking refresh token as used: %w", err)
+	}
+
+	query = `INSERT INTO refresh_tokens (token_hash, family_id, username, expires_at)
+		SELECT $1, family_id, username, $2 FROM refresh_tokens WHERE token_hash = $3`
+	if _, err := tx.Exec(ctx, query, next.Hash, next.ExpiresAt, oldHash); err != nil {
+		return "", fmt.Errorf("error storing refresh token: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return username, nil
+}
+
+// RevokeRefreshTokenFamily revokes every refresh token descended from the same login as the given one.
+func (p *Postgres) RevokeRefreshTokenFamily(ctx context.Context, hash, username string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW()
+		WHERE family_id = (SELECT family_id FROM refresh_tokens WHERE token_hash = $1 AND username = $2)
+		AND revoked_at IS NULL`
+
+	if _, err := p.db.Exec(ctx, query, hash, username); err != nil {
+		return fmt.Errorf("error revoking refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) RevokeToken(ctx context.Context, token RevokedToken, username string) error {
+	query := `INSERT INTO revoked_tokens (jti, username, expires_at) VALUES ($1, $2, $3) ON CONFLICT (jti) DO NOTHING`
+
+	if _, err := p.db.Exec(ctx, query, token.JTI, username, token.ExpiresAt); err != nil {
+		return fmt.Errorf("error revoking token: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeAllSessions invalidates every access token issued to the employee up to now
+// together with all of their refresh tokens and returns the cutoff moment.
+func (p *Postgres) RevokeAllSessions(ctx context.Context, username string) (time.Time, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM employees WHERE username = $1)`, username).Scan(&exists); err != nil {
+		return time.Time{}, fmt.Errorf("error fetching employee: %w", err)
+	}
+
+	if !exists {
+		return time.Time{}, ErrEmployeeNotFound
+	}
+
+	revokedBefore, err := revokeSessions(ctx, tx, username)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return time.Time{}, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return revokedBefore, nil
+}
+
+func revokeSessions(ctx context.Context, tx pgx.Tx, username string) (time.Time, error) {
+	var revokedBefore time.Time
+	query := `INSERT INTO session_revocations (username, revoked_before) VALUES ($1, NOW())
+		ON CONFLICT (username) DO UPDATE SET revoked_before = EXCLUDED.revoked_before, updated_at = NOW()
+		RETURNING revoked_before`
+	if err := tx.QueryRow(ctx, query, username).Scan(&revokedBefore); err != nil {
+		return time.Time{}, fmt.Errorf("error revoking sessions: %w", err)
+	}
+
+	query = `UPDATE refresh_tokens SET revoked_at = NOW() WHERE username = $1 AND revoked_at IS NULL`
+	if _, err := tx.Exec(ctx, query, username); err != nil {
+		return time.Time{}, fmt.Errorf("error revoking refresh tokens: %w", err)
+	}
+
+	return revokedBefore, nil
+}
+
+// ListRevocations returns still relevant denylist entries created after since.
+func (p *Postgres) ListRevocations(ctx context.Context, since time.Time) (*Revocations, error) {
+	var revocations Revocations
+
+	query := `SELECT jti, expires_at FROM revoked_tokens WHERE revoked_at > $1 AND expires_at > NOW()`
+
+	rows, err := p.db.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching revoked tokens: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token RevokedToken
+		if err := rows.Scan(&token.JTI, &token.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("error fetching revoked tokens: %w", err)
+		}
+
+		revocations.Tokens = append(revocations.Tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching revoked tokens: %w", err)
+	}
+
+	query = `SELECT username, revoked_before FROM session_revocations WHERE updated_at > $1`
+
+	rows, err = p.db.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session revocations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var session SessionRevocation
+		if err := rows.Scan(&session.Username, &session.RevokedBefore); err != nil {
+			return nil, fmt.Errorf("error fetching session revocations: %w", err)
+		}
+
+		revocations.Sessions = append(revocations.Sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching session revocations: %w", err)
+	}
+
+	return &revocations, nil
+}
+
+func (p *Postgres) GetEmployeeRole(ctx context.Context, username string) (string, error) {
+	var role string
+
+	err := p.db.QueryRow(ctx, `SELECT role FROM employees WHERE username = $1`, username).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrEmployeeNotFound
+		}
+
+		return "", fmt.Errorf("error fetching employee role: %w", err)
+	}
+
+	return role, nil
+}
+
+func (p *Postgres) SetEmployeeRole(ctx context.Context, username, role string) error {
+	tag, err := p.db.Exec(ctx, `UPDATE employees SET role = $1, updated_at = NOW() WHERE username = $2`, role, username)
+	if err != nil {
+		return fmt.Errorf("error updating employee role: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEmployeeNotFound
+	}
+
+	return nil
+}
+
+// GetBlockedUntil returns the latest moment until which any of the keys is blocked
+// or the zero time when none of them is.
+func (p *Postgres) GetBlockedUntil(ctx context.Context, keys []string) (time.Time, error) {
+	var blockedUntil *time.Time
+
+	query := `SELECT MAX(blocked_until) FROM auth_failures WHERE key = ANY($1) AND blocked_until > NOW()`
+	if err := p.db.QueryRow(ctx, query, keys).Scan(&blockedUntil); err != nil {
+		return time.Time{}, fmt.Errorf("error fetching login blocks: %w", err)
+	}
+
+	if blockedUntil == nil {
+		return time.Time{}, nil
+	}
+
+	return *blockedUntil, nil
+}
+
+// RecordAuthFailure counts a failed login for the key and returns the number of consecutive failures.
+// The counter starts over when the previous failure is older than resetAfter.
+func (p *Postgres) RecordAuthFailure(ctx context.Context, key string, resetAfter time.Duration) (int, error) {
+	var failures int
+
+	query := `INSERT INTO auth_failures (key, failures, last_failure) VALUES ($1, 1, NOW())
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE
+				WHEN $2::float8 > 0 AND auth_failures.last_failure < NOW() - make_interval(secs => $2::float8) THEN 1
+				ELSE auth_failures.failures + 1
+			END,
+			last_failure = NOW()
+		RETURNING failures`
+	if err := p.db.QueryRow(ctx, query, key, resetAfter.Seconds()).Scan(&failures); err != nil {
+		return 0, fmt.Errorf("error recording login failure: %w", err)
+	}
+
+	return failures, nil
+}
+
+func (p *Postgres) BlockAuthKey(ctx context.Context, key string, until time.Time) error {
+	if _, err := p.db.Exec(ctx, `UPDATE auth_failures SET blocked_until = $1 WHERE key = $2`, until, key); err != nil {
+		return fmt.Errorf("error blocking login: %w", err)
+	}
+
+	return nil
+}
+
+// LockAuthKey blocks the key and records the lockout so support can find and lift it.
+func (p *Postgres) LockAuthKey(ctx context.Context, event LockoutEvent) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `UPDATE auth_failures SET blocked_until = $1 WHERE key = $2`, event.LockedUntil, event.Key); err != nil {
+		return fmt.Errorf("error locking login: %w", err)
+	}
+
+	query := `INSERT INTO lockout_events (key, failures, locked_until) VALUES ($1, $2, $3)`
+	if _, err := tx.Exec(ctx, query, event.Key, event.Failures, event.LockedUntil); err != nil {
+		return fmt.Errorf("error recording lockout: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) ResetAuthFailures(ctx context.Context, key string) error {
+	if _, err := p.db.Exec(ctx, `DELETE FROM auth_failures WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("error resetting login failures: %w", err)
+	}
+
+	return nil
+}
+
+// ListLockouts returns lockouts that are still in effect.
+func (p *Postgres) ListLockouts(ctx context.Context) ([]LockoutEvent, error) {
+	query := `SELECT id, key, failures, locked_until, created_at FROM lockout_events
+		WHERE unlocked_at IS NULL AND locked_until > NOW() ORDER BY created_at DESC`
+
+	rows, err := p.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching lockouts: %w", err)
+	}
+	defer rows.Close()
+
+	events := []LockoutEvent{}
+	for rows.Next() {
+		var event LockoutEvent
+		if err := rows.Scan(&event.ID, &event.Key, &event.Failures, &event.LockedUntil, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error fetching lockouts: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching lockouts: %w", err)
+	}
+
+	return events, nil
+}
+
+// UnlockAuthKey lifts the block and forgets the failures of the key.
+func (p *Postgres) UnlockAuthKey(ctx context.Context, key, unlockedBy string) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM auth_failures WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("error resetting login failures: %w", err)
+	}
+
+	query := `UPDATE lockout_events SET unlocked_by = $1, unlocked_at = NOW() WHERE key = $2 AND unlocked_at IS NULL`
+	if _, err := tx.Exec(ctx, query, unlockedBy, key); err != nil {
+		return fmt.Errorf("error unlocking login: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ChangePassword stores the new hash and revokes every session of the employee,
+// so a leaked password or token stops working together with the old password.
+func (p *Postgres) ChangePassword(ctx context.Context, username, passwordHash string) (time.Time, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	revokedBefore, err := setPassword(ctx, tx, username, passwordHash)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return time.Time{}, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return revokedBefore, nil
+}
+
+// CreatePasswordReset stores a reset token and invalidates the ones issued before it.
+func (p *Postgres) CreatePasswordReset(ctx context.Context, reset PasswordReset) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM employees WHERE username = $1)`, reset.Username).Scan(&exists); err != nil {
+		return fmt.Errorf("error fetching employee: %w", err)
+	}
+
+	if !exists {
+		return ErrEmployeeNotFound
+	}
+
+	query := `UPDATE password_resets SET used_at = NOW() WHERE username = $1 AND used_at IS NULL`
+	if _, err := tx.Exec(ctx, query, reset.Username); err != nil {
+		return fmt.Errorf("error invalidating password resets: %w", err)
+	}
+
+	query = `INSERT INTO password_resets (token_hash, username, created_by, expires_at) VALUES ($1, $2, $3, $4)`
+	if _, err := tx.Exec(ctx, query, reset.Hash, reset.Username, reset.CreatedBy, reset.ExpiresAt); err != nil {
+		return fmt.Errorf("error creating password reset: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword consumes the reset token and sets the new password in one transaction,
+// so a token can never be used twice. It returns the employee the token was issued for.
+func (p *Postgres) ResetPassword(ctx context.Context, resetHash, passwordHash string) (string, time.Time, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		username string
+		valid    bool
+	)
+
+	query := `SELECT username, used_at IS NULL AND expires_at > NOW() FROM password_resets WHERE token_hash = $1 FOR UPDATE`
+	if err := tx.QueryRow(ctx, query, resetHash).Scan(&username, &valid); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", time.Time{}, ErrPasswordResetInvalid
+		}
+
+		return "", time.Time{}, fmt.Errorf("error fetching password reset: %w", err)
+	}
+
+	if !valid {
+		return "", time.Time{}, ErrPasswordResetInvalid
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE password_resets SET used_at = NOW() WHERE token_hash = $1`, resetHash); err != nil {
+		return "", time.Time{}, fmt.Errorf("error redeeming password reset: %w", err)
+	}
+
+	revokedBefore, err := setPassword(ctx, tx, username, passwordHash)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", time.Time{}, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return username, revokedBefore, nil
+}
+
+func setPassword(ctx context.Context, tx pgx.Tx, username, passwordHash string) (time.Time, error) {
+	tag, err := tx.Exec(ctx, `UPDATE employees SET pass = $1 WHERE username = $2`, passwordHash, username)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error updating password: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return time.Time{}, ErrEmployeeNotFound
+	}
+
+	return revokeSessions(ctx, tx, username)
+}
+
+func (p *Postgres) GetMFA(ctx context.Context, username string) (*MFA, error) {
+	var mfa MFA
+
+	query := `SELECT secret, confirmed_at IS NOT NULL, last_step FROM employee_mfa WHERE username = $1`
+	if err := p.db.QueryRow(ctx, query, username).Scan(&mfa.Secret, &mfa.Confirmed, &mfa.LastStep); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMFANotFound
+		}
+
+		return nil, fmt.Errorf("error fetching mfa: %w", err)
+	}
+
+	return &mfa, nil
+}
+
+// EnrollMFA starts a new unconfirmed enrollment, replacing an earlier unconfirmed one
+// together with its recovery codes.
+func (p *Postgres) EnrollMFA(ctx context.Context, username, secret string, recoveryHashes []string) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var confirmed bool
+	query := `SELECT confirmed_at IS NOT NULL FROM employee_mfa WHERE username = $1 FOR UPDATE`
+	if err := tx.QueryRow(ctx, query, username).Scan(&confirmed); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("error fetching mfa: %w", err)
+	}
+
+	if confirmed {
+		return ErrMFAEnabled
+	}
+
+	query = `INSERT INTO employee_mfa (username, secret) VALUES ($1, $2)
+		ON CONFLICT (username) DO UPDATE SET secret = EXCLUDED.secret, last_step = 0, created_at = NOW()`
+	if _, err := tx.Exec(ctx, query, username, secret); err != nil {
+		return fmt.Errorf("error enrolling mfa: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE username = $1`, username); err != nil {
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+
+	for _, hash := range recoveryHashes {
+		query = `INSERT INTO mfa_recovery_codes (code_hash, username) VALUES ($1, $2)`
+		if _, err := tx.Exec(ctx, query, hash, username); err != nil {
+			return fmt.Errorf("error storing recovery code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmMFA enables a pending enrollment once the employee proved the authenticator works.
+func (p *Postgres) ConfirmMFA(ctx context.Context, username string, step int64) error {
+	query := `UPDATE employee_mfa SET confirmed_at = NOW(), last_step = $2 WHERE username = $1 AND confirmed_at IS NULL`
+
+	tag, err := p.db.Exec(ctx, query, username, step)
+	if err != nil {
+		return fmt.Errorf("error confirming mfa: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMFANotFound
+	}
+
+	return nil
+}
+
+// UseMFAStep records an accepted code. It fails for a step that is not newer than the last one,
+// which makes every code single-use even under concurrent logins.
+func (p *Postgres) UseMFAStep(ctx context.Context, username string, step int64) error {
+	query := `UPDATE employee_mfa SET last_step = $2 WHERE username = $1 AND confirmed_at IS NOT NULL AND last_step < $2`
+
+	tag, err := p.db.Exec(ctx, query, username, step)
+	if err != nil {
+		return fmt.Errorf("error recording mfa code: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMFACodeInvalid
+	}
+
+	return nil
+}
+
+func (p *Postgres) UseRecoveryCode(ctx context.Context, username, codeHash string) error {
+	query := `UPDATE mfa_recovery_codes SET used_at = NOW() WHERE code_hash = $1 AND username = $2 AND used_at IS NULL`
+
+	tag, err := p.db.Exec(ctx, query, codeHash, username)
+	if err != nil {
+		return fmt.Errorf("error redeeming recovery code: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMFACodeInvalid
+	}
+
+	return nil
+}
+
+func (p *Postgres) DisableMFA(ctx context.Context, username string) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE username = $1`, username); err != nil {
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM employee_mfa WHERE username = $1`, username); err != nil {
+		return fmt.Errorf("error disabling mfa: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateOIDCLogin stores a pending single-sign-on attempt and drops the expired ones.
+func (p *Postgres) CreateOIDCLogin(ctx context.Context, login OIDCLogin) error {
+	if _, err := p.db.Exec(ctx, `DELETE FROM oidc_logins WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("error cleaning up sign-on attempts: %w", err)
+	}
+
+	query := `INSERT INTO oidc_logins (state_hash, nonce, code_verifier, expires_at) VALUES ($1, $2, $3, $4)`
+	if _, err := p.db.Exec(ctx, query, login.StateHash, login.Nonce, login.CodeVerifier, login.ExpiresAt); err != nil {
+		return fmt.Errorf("error creating sign-on attempt: %w", err)
+	}
+
+	return nil
+}
+
+// TakeOIDCLogin consumes the pending single-sign-on attempt with the given state, so it can be completed only once.
+func (p *Postgres) TakeOIDCLogin(ctx context.Context, stateHash string) (*OIDCLogin, error) {
+	var login OIDCLogin
+
+	query := `DELETE FROM oidc_logins WHERE state_hash = $1 AND expires_at > NOW()
+		RETURNING state_hash, nonce, code_verifier, expires_at`
+
+	err := p.db.QueryRow(ctx, query, stateHash).Scan(&login.StateHash, &login.Nonce, &login.CodeVerifier, &login.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOIDCLoginInvalid
+		}
+
+		return nil, fmt.Errorf("error fetching sign-on attempt: %w", err)
+	}
+
+	return &login, nil
+}
+
+// LinkIdentity returns the employee linked to the issuer and subject of the identity.
+// An unknown subject is linked to the employee named identity.Username on its first login.
+func (p *Postgres) LinkIdentity(ctx context.Context, identity ExternalIdentity) (string, error) {
+	var username string
+
+	query := `UPDATE employee_identities SET last_login_at = NOW() WHERE issuer = $1 AND subject = $2 RETURNING username`
+
+	err := p.db.QueryRow(ctx, query, identity.Issuer, identity.Subject).Scan(&username)
+	if err == nil {
+		return username, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("error fetching identity: %w", err)
+	}
+
+	if identity.Username == "" {
+		return "", ErrIdentityNotLinked
+	}
+
+	query = `INSERT INTO employee_identities (issuer, subject, username, last_login_at) VALUES ($1, $2, $3, NOW())`
+
+	if _, err := p.db.Exec(ctx, query, identity.Issuer, identity.Subject, identity.Username); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case foreignKeyViolationCode:
+				return "", ErrIdentityNotLinked
+			case uniqueViolationCode:
+				return "", ErrIdentityConflict
+			}
+		}
+
+		return "", fmt.Errorf("error linking identity: %w", err)
+	}
+
+	return identity.Username, nil
+}
+
+func (p *Postgres) CreateServiceAccount(ctx context.Context, account ServiceAccount) error {
+	query := `INSERT INTO service_accounts (name, description, created_by) VALUES ($1, $2, $3)`
+
+	if _, err := p.db.Exec(ctx, query, account.Name, account.Description, account.CreatedBy); err != nil {
+		if isUniqueViolation(err) {
+			return ErrServiceAccountExists
+		}
+
+		return fmt.Errorf("error creating service account: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) CreateAPIKey(ctx context.Context, key APIKey) error {
+	query := `INSERT INTO api_keys (prefix, key_hash, account, scopes, expires_at, created_by) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := p.db.Exec(ctx, query, key.Prefix, key.Hash, key.Account, key.Scopes, key.ExpiresAt, key.CreatedBy)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return ErrServiceAccountNotFound
+		}
+
+		return fmt.Errorf("error creating api key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) ListAPIKeys(ctx context.Context, account string) ([]APIKey, error) {
+	query := `SELECT prefix, account, scopes, expires_at, created_by, created_at, last_used_at, revoked_at
+		FROM api_keys WHERE account = $1 ORDER BY created_at DESC`
+
+	rows, err := p.db.Query(ctx, query, account)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []APIKey{}
+	for rows.Next() {
+		var key APIKey
+		if err := rows.Scan(&key.Prefix, &key.Account, &key.Scopes, &key.ExpiresAt, &key.CreatedBy,
+			&key.CreatedAt, &key.LastUsedAt, &key.RevokedAt); err != nil {
+			return nil, fmt.Errorf("error fetching api keys: %w", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (p *Postgres) RevokeAPIKey(ctx context.Context, account, prefix string) error {
+	query := `UPDATE api_keys SET revoked_at = NOW() WHERE account = $1 AND prefix = $2 AND revoked_at IS NULL`
+
+	tag, err := p.db.Exec(ctx, query, account, prefix)
+	if err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// apiKeyUseResolution is how stale last_used_at may get before a request with the key rewrites it,
+// so busy service accounts do not turn every call into a write.
+const apiKeyUseResolution = time.Minute
+
+// AuthenticateAPIKey returns a usable key matching both the prefix and the hash and records its use
+// when the recorded one is older than apiKeyUseResolution.
+func (p *Postgres) AuthenticateAPIKey(ctx context.Context, prefix, hash string) (*APIKey, error) {
+	var key APIKey
+
+	query := `SELECT prefix, account, scopes, expires_at, last_used_at FROM api_keys
+		WHERE prefix = $1 AND key_hash = $2 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())`
+	if err := p.db.QueryRow(ctx, query, prefix, hash).Scan(&key.Prefix, &key.Account, &key.Scopes, &key.ExpiresAt, &key.LastUsedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+
+		return nil, fmt.Errorf("error fetching api key: %w", err)
+	}
+
+	if key.LastUsedAt != nil && time.Since(*key.LastUsedAt) < apiKeyUseResolution {
+		return &key, nil
+	}
+
+	// the condition is repeated so concurrent requests with a stale key write it once
+	query = `UPDATE api_keys SET last_used_at = NOW()
+		WHERE prefix = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - make_interval(secs => $2::float8))`
+	if _, err := p.db.Exec(ctx, query, key.Prefix, apiKeyUseResolution.Seconds()); err != nil {
+		// the key is valid; a missed timestamp must not fail the request
+		logger.Warn(fmt.Errorf("error recording api key use: %w", err))
+	}
+
+	return &key, nil
+}
+
+// GrantCoins credits the employee and records who granted the coins and why.
+func (p *Postgres) GrantCoins(ctx context.Context, grant CoinGrant) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := saveIdempotencyRecord(ctx, tx); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE employees SET balance = balance + $1 WHERE username = $2`, grant.Amount, grant.Receiver)
+	if err != nil {
+		return fmt.Errorf("error crediting coins: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEmployeeNotFound
+	}
+
+	query := `INSERT INTO coin_grants (receiver, amount, reason, granted_by) VALUES ($1, $2, $3, $4)`
+	if _, err := tx.Exec(ctx, query, grant.Receiver, grant.Amount, grant.Reason, grant.GrantedBy); err != nil {
+		return fmt.Errorf("error recording coin grant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PutItem adds the item to the catalog or changes its price.
+func (p *Postgres) PutItem(ctx context.Context, item string, price int) error {
+	query := `INSERT INTO merch_shop (product_name, price) VALUES ($1, $2)
+		ON CONFLICT (product_name) DO UPDATE SET price = EXCLUDED.price, updated_at = NOW()`
+
+	if _, err := p.db.Exec(ctx, query, item, price); err != nil {
+		return fmt.Errorf("error saving item: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) ListBalances(ctx context.Context) ([]EmployeeBalance, error) {
+	rows, err := p.db.Query(ctx, `SELECT username, balance FROM employees ORDER BY username`)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching balances: %w", err)
+	}
+	defer rows.Close()
+
+	balances := []EmployeeBalance{}
+	for rows.Next() {
+		var balance EmployeeBalance
+		if err := rows.Scan(&balance.Username, &balance.Balance); err != nil {
+			return nil, fmt.Errorf("error fetching balances: %w", err)
+		}
+
+		balances = append(balances, balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error fetching balances: %w", err)
+	}
+
+	return balances, nil
+}
+
+// upsertIdempotencyRecord stores a record unless the key holds one that has not expired yet.
+// A response without a body comes as a nil slice, which pgx sends as NULL.
+const upsertIdempotencyRecord = `
+	INSERT INTO idempotency_keys (principal, key, fingerprint, status_code, content_type, body, expires_at)
+	VALUES ($1, $2, $3, $4, $5, COALESCE($6, ''::bytea), $7)
+	ON CONFLICT (principal, key) DO UPDATE SET
+		fingerprint = EXCLUDED.fingerprint,
+		status_code = EXCLUDED.status_code,
+		content_type = EXCLUDED.content_type,
+		body = EXCLUDED.body,
+		created_at = NOW(),
+		expires_at = EXCLUDED.expires_at
+	WHERE idempotency_keys.expires_at <= NOW()`
+
+// saveIdempotencyRecord stores the response prepared for the idempotency key of the request in
+// the ledger transaction tx, so the change is made at most once per key. A concurrent request
+// holding the key makes it wait for that request to finish and then fail with ErrKeyTaken.
+func saveIdempotencyRecord(ctx context.Context, tx pgx.Tx) error {
+	record := idempotency.Prepared(ctx)
+	if record == nil {
+		return nil
+	}
+
+	tag, err := tx.Exec(ctx, upsertIdempotencyRecord, record.Principal, record.Key, record.Fingerprint,
+		record.StatusCode, record.ContentType, record.Body, record.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error saving idempotency key: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return idempotency.ErrKeyTaken
+	}
+
+	return nil
+}
+
+// GetIdempotencyRecord returns the response stored for the key of the principal, unless it has expired.
+func (p *Postgres) GetIdempotencyRecord(ctx context.Context, principal, key string) (*idempotency.Record, error) {
+	record := idempotency.Record{Principal: principal, Key: key}
+
+	query := `SELECT fingerprint, status_code, content_type, body, expires_at FROM idempotency_keys
+		WHERE principal = $1 AND key = $2 AND expires_at > NOW()`
+
+	err := p.db.QueryRow(ctx, query, principal, key).
+		Scan(&record.Fingerprint, &record.StatusCode, &record.ContentType, &record.Body, &record.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, idempotency.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("error fetching idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+// SaveIdempotencyRecord stores the response of a request that changed no ledger. A record
+// the key already holds is kept.
+func (p *Postgres) SaveIdempotencyRecord(ctx context.Context, record idempotency.Record) error {
+	_, err := p.db.Exec(ctx, upsertIdempotencyRecord, record.Principal, record.Key, record.Fingerprint,
+		record.StatusCode, record.ContentType, record.Body, record.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error saving idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeIdempotencyRecords drops the expired records.
+func (p *Postgres) PurgeIdempotencyRecords(ctx context.Context) error {
+	if _, err := p.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("error purging idempotency keys: %w", err)
+	}
+
+	return nil
+}
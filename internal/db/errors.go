@@ -0,0 +1,34 @@
+package db
+
+import "errors"
+
+var (
+	ErrEmployeeNotFound = errors.New("employee not found")
+	ErrEmployeeExists   = errors.New("employee already exists")
+	ErrInviteInvalid    = errors.New("invite code is invalid, expired or already used")
+
+	ErrPasswordResetInvalid = errors.New("password reset token is invalid, expired or already used")
+
+	ErrOIDCLoginInvalid  = errors.New("single sign-on attempt is invalid or expired")
+	ErrIdentityNotLinked = errors.New("external identity is not linked to an employee")
+	ErrIdentityConflict  = errors.New("employee is already linked to another external identity")
+
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrServiceAccountExists   = errors.New("service account already exists")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+
+	ErrMFANotFound    = errors.New("two-factor authentication is not set up")
+	ErrMFAEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrMFACodeInvalid = errors.New("one-time code is invalid or already used")
+
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenExpired  = errors.New("refresh token expired")
+	ErrRefreshTokenRevoked  = errors.New("refresh token revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token reuse detected")
+
+	ErrItemNotFound      = errors.New("item not found")
+	ErrInsufficientFunds = errors.New("not enough coins on balance")
+	ErrUnknownRecipient  = errors.New("recipient not found")
+	ErrSelfTransfer      = errors.New("sender and receiver cannot be the same")
+	ErrInvalidAmount     = errors.New("transfer amount must be positive")
+)
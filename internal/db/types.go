@@ -29,3 +29,105 @@ type SendCoinRequest struct {
 	ToUser string `json:"toUser" binding:"required"`
 	Amount int    `json:"amount" binding:"required"`
 }
+
+type RefreshToken struct {
+	Hash      string
+	Username  string
+	ExpiresAt time.Time
+}
+
+type RevokedToken struct {
+	JTI       string
+	ExpiresAt time.Time
+}
+
+type SessionRevocation struct {
+	Username      string
+	RevokedBefore time.Time
+}
+
+// Revocations is a batch of denylist changes made after a given moment.
+type Revocations struct {
+	Tokens   []RevokedToken
+	Sessions []SessionRevocation
+}
+
+type Invite struct {
+	Hash      string
+	CreatedBy string
+	ExpiresAt time.Time
+}
+
+// PasswordReset is a one-time token issued by a finance-admin to set a new password for an employee.
+type PasswordReset struct {
+	Hash      string
+	Username  string
+	CreatedBy string
+	ExpiresAt time.Time
+}
+
+// MFA is the TOTP enrollment of an employee. It is enforced only once confirmed.
+// LastStep is the time step of the last accepted code, codes up to it are refused.
+type MFA struct {
+	Secret    string
+	Confirmed bool
+	LastStep  int64
+}
+
+type ServiceAccount struct {
+	Name        string
+	Description string
+	CreatedBy   string
+}
+
+// APIKey is a key of a service account. Only the hash of the key is stored,
+// Prefix is its public part used to find it and to tell keys apart.
+type APIKey struct {
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"`
+	Account    string     `json:"account"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+// ExternalIdentity links the subject of an external identity provider to an employee.
+type ExternalIdentity struct {
+	Issuer   string
+	Subject  string
+	Username string
+}
+
+// OIDCLogin is a single-sign-on attempt waiting for the provider to redirect the employee back.
+// Only the hash of the state parameter is stored.
+type OIDCLogin struct {
+	StateHash    string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// CoinGrant credits coins to an employee out of the company budget, e.g. kudos from a bot.
+type CoinGrant struct {
+	Receiver  string
+	Amount    int
+	Reason    string
+	GrantedBy string
+}
+
+type EmployeeBalance struct {
+	Username string `json:"username"`
+	Balance  int    `json:"balance"`
+}
+
+// LockoutEvent records a login key (username or client IP) locked after too many failed attempts.
+type LockoutEvent struct {
+	ID          int64     `json:"id"`
+	Key         string    `json:"key"`
+	Failures    int       `json:"failures"`
+	LockedUntil time.Time `json:"lockedUntil"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
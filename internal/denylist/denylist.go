@@ -0,0 +1,140 @@
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/db"
+	"github.com/basedalex/merch-shop/internal/logging"
+)
+
+var logger = logging.Logger("denylist")
+
+const DefaultSyncInterval = 30 * time.Second
+
+// syncOverlap makes consecutive syncs overlap so entries committed
+// while the previous sync was running are not missed.
+const syncOverlap = 5 * time.Second
+
+type Store interface {
+	ListRevocations(ctx context.Context, since time.Time) (*db.Revocations, error)
+}
+
+// Denylist is an in-process copy of the revoked tokens stored in Postgres.
+// Lookups never touch the database; the copy is refreshed periodically by Run
+// and updated immediately for revocations made by this instance.
+type Denylist struct {
+	store Store
+
+	mu       sync.RWMutex
+	tokens   map[string]time.Time
+	sessions map[string]time.Time
+	lastSync time.Time
+}
+
+func New(store Store) *Denylist {
+	return &Denylist{
+		store:    store,
+		tokens:   make(map[string]time.Time),
+		sessions: make(map[string]time.Time),
+	}
+}
+
+// IsRevoked reports whether the token was revoked on its own
+// or issued before all sessions of its owner were revoked.
+func (d *Denylist) IsRevoked(claims *auth.Claims) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if _, ok := d.tokens[claims.Id]; ok {
+		return true
+	}
+
+	if before, ok := d.sessions[claims.Username]; ok && !claims.IssuedAtTime().After(before) {
+		return true
+	}
+
+	return false
+}
+
+func (d *Denylist) Revoke(jti string, expiresAt time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.tokens[jti] = expiresAt
+}
+
+func (d *Denylist) RevokeUser(username string, before time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if current, ok := d.sessions[username]; !ok || before.After(current) {
+		d.sessions[username] = before
+	}
+}
+
+// Sync loads revocations made since the previous sync and drops entries
+// that can no longer match a valid token.
+func (d *Denylist) Sync(ctx context.Context) error {
+	started := time.Now()
+
+	d.mu.RLock()
+	since := d.lastSync
+	d.mu.RUnlock()
+
+	revocations, err := d.store.ListRevocations(ctx, since)
+	if err != nil {
+		return fmt.Errorf("error syncing denylist: %w", err)
+	}
+
+	for _, token := range revocations.Tokens {
+		d.Revoke(token.JTI, token.ExpiresAt)
+	}
+
+	for _, session := range revocations.Sessions {
+		d.RevokeUser(session.Username, session.RevokedBefore)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for jti, expiresAt := range d.tokens {
+		if started.After(expiresAt) {
+			delete(d.tokens, jti)
+		}
+	}
+
+	for username, before := range d.sessions {
+		if started.Sub(before) > auth.AccessTokenTTL {
+			delete(d.sessions, username)
+		}
+	}
+
+	d.lastSync = started.Add(-syncOverlap)
+
+	return nil
+}
+
+// Run keeps the denylist in sync until ctx is cancelled.
+func (d *Denylist) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.Sync(ctx); err != nil {
+				logger.Warn(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package denylist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+)
+
+func TestRevokeUser(t *testing.T) {
+	revokedAt := time.Date(2025, 3, 5, 9, 0, 0, 500_000_000, time.UTC)
+	issuedAt := func(at time.Time) *auth.Claims {
+		return &auth.Claims{
+			Username:       "alice",
+			IssuedAtMicro:  at.UnixMicro(),
+			StandardClaims: jwt.StandardClaims{Id: "jti", IssuedAt: at.Unix()},
+		}
+	}
+
+	d := New(nil)
+	d.RevokeUser("alice", revokedAt)
+
+	assert.True(t, d.IsRevoked(issuedAt(revokedAt.Add(-time.Second))), "tokens issued before the revocation are rejected")
+	assert.True(t, d.IsRevoked(issuedAt(revokedAt.Add(-200*time.Millisecond))), "even in the second of the revocation")
+	assert.False(t, d.IsRevoked(issuedAt(revokedAt.Add(200*time.Millisecond))), "a login in the same second is accepted")
+
+	legacy := issuedAt(revokedAt.Add(200 * time.Millisecond))
+	legacy.IssuedAtMicro = 0
+	assert.True(t, d.IsRevoked(legacy), "without microseconds the second of the revocation is rejected")
+
+	other := issuedAt(revokedAt.Add(-time.Second))
+	other.Username = "bob"
+	assert.False(t, d.IsRevoked(other), "other users keep their sessions")
+}
+
+func TestRevoke(t *testing.T) {
+	d := New(nil)
+	d.Revoke("jti-1", time.Now().Add(time.Minute))
+
+	assert.True(t, d.IsRevoked(&auth.Claims{StandardClaims: jwt.StandardClaims{Id: "jti-1"}}))
+	assert.False(t, d.IsRevoked(&auth.Claims{StandardClaims: jwt.StandardClaims{Id: "jti-2"}}))
+}
@@ -0,0 +1,63 @@
+// Package docs serves the OpenAPI document of the API and Swagger UI to explore it. Swagger UI
+// is embedded in the binary, so the page works without a CDN.
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/basedalex/merch-shop/internal/logging"
+)
+
+var logger = logging.Logger("docs")
+
+// Paths of the document and of Swagger UI; the files of Swagger UI are served under UIPath.
+const (
+	JSONPath = "/openapi.json"
+	YAMLPath = "/openapi.yaml"
+	UIPath   = "/docs"
+)
+
+// initializer points Swagger UI at JSONPath instead of the petstore of the upstream bundle.
+//
+//go:embed swagger-initializer.js
+var initializer []byte
+
+// Routes serves spec at JSONPath and YAMLPath and Swagger UI at UIPath.
+func Routes(r chi.Router, spec *openapi3.T) error {
+	jsonDoc, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("error encoding the OpenAPI document as JSON: %w", err)
+	}
+
+	yamlDoc, err := yaml.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("error encoding the OpenAPI document as YAML: %w", err)
+	}
+
+	r.Get(JSONPath, serve("application/json", jsonDoc))
+	r.Get(YAMLPath, serve("application/yaml", yamlDoc))
+
+	r.Get(UIPath, http.RedirectHandler(UIPath+"/", http.StatusMovedPermanently).ServeHTTP)
+	r.Get(UIPath+"/swagger-initializer.js", serve("text/javascript; charset=utf-8", initializer))
+	r.Get(UIPath+"/*", http.StripPrefix(UIPath+"/", http.FileServerFS(swaggerFiles.FS)).ServeHTTP)
+
+	return nil
+}
+
+func serve(contentType string, body []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+
+		if _, err := w.Write(body); err != nil {
+			logger.Warn(err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/basedalex/merch-shop/internal/middleware"
+	api "github.com/basedalex/merch-shop/internal/swagger"
+)
+
+func newRouter(t *testing.T) http.Handler {
+	t.Helper()
+
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(middleware.Authentication(nil, nil))
+	require.NoError(t, Routes(r, spec))
+
+	return r
+}
+
+func get(t *testing.T, router http.Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return rec
+}
+
+func TestDocument(t *testing.T) {
+	router := newRouter(t)
+
+	rec := get(t, router, JSONPath)
+	require.Equal(t, http.StatusOK, rec.Code, "the document is served without a token")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var fromJSON map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &fromJSON))
+	assert.Contains(t, fromJSON, "openapi")
+	assert.Contains(t, fromJSON["paths"], "/api/info")
+
+	rec = get(t, router, YAMLPath)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+
+	var fromYAML map[string]any
+	require.NoError(t, yaml.Unmarshal(rec.Body.Bytes(), &fromYAML))
+	assert.Equal(t, fromJSON["openapi"], fromYAML["openapi"])
+}
+
+func TestUI(t *testing.T) {
+	router := newRouter(t)
+
+	rec := get(t, router, UIPath)
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, UIPath+"/", rec.Header().Get("Location"))
+
+	rec = get(t, router, UIPath+"/")
+	require.Equal(t, http.StatusOK, rec.Code, "the page is served without a token")
+	assert.Contains(t, rec.Body.String(), "swagger-ui-bundle.js")
+
+	rec = get(t, router, UIPath+"/swagger-initializer.js")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), JSONPath, "the page loads the document of this server")
+
+	rec = get(t, router, UIPath+"/swagger-ui-bundle.js")
+	assert.Equal(t, http.StatusOK, rec.Code, "the bundle is served from the binary")
+}
+
+func TestOtherPathsNeedToken(t *testing.T) {
+	rec := get(t, newRouter(t), "/openapi.json.bak")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDB struct {
+	pingErr           error
+	current, expected int64
+	versionErr        error
+}
+
+func (f fakeDB) Ping(_ context.Context) error {
+	return f.pingErr
+}
+
+func (f fakeDB) MigrationVersion(_ context.Context) (int64, int64, error) {
+	return f.current, f.expected, f.versionErr
+}
+
+func probe(t *testing.T, handler http.HandlerFunc, path string) (int, Response) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var resp Response
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+
+	return rec.Code, resp
+}
+
+func TestLiveness(t *testing.T) {
+	checker := NewChecker(fakeDB{pingErr: errors.New("connection refused")})
+
+	code, resp := probe(t, checker.Liveness, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, resp.Status)
+}
+
+func TestReadiness(t *testing.T) {
+	tests := []struct {
+		name   string
+		db     fakeDB
+		drain  bool
+		code   int
+		checks map[string]string
+	}{
+		{
+			name:   "ready",
+			db:     fakeDB{current: 7, expected: 7},
+			code:   http.StatusOK,
+			checks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "ok"},
+		},
+		{
+			name:   "database unreachable",
+			db:     fakeDB{pingErr: errors.New("dial tcp: connection refused"), versionErr: errors.New("no connection")},
+			code:   http.StatusServiceUnavailable,
+			checks: map[string]string{"database": "unreachable", "migrations": "unknown version", "shutdown": "ok"},
+		},
+		{
+			name:   "schema behind",
+			db:     fakeDB{current: 6, expected: 7},
+			code:   http.StatusServiceUnavailable,
+			checks: map[string]string{"database": "ok", "migrations": "at version 6, expected 7", "shutdown": "ok"},
+		},
+		{
+			name:   "schema ahead",
+			db:     fakeDB{current: 8, expected: 7},
+			code:   http.StatusOK,
+			checks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "ok"},
+		},
+		{
+			name:   "draining",
+			db:     fakeDB{current: 7, expected: 7},
+			drain:  true,
+			code:   http.StatusServiceUnavailable,
+			checks: map[string]string{"database": "ok", "migrations": "ok", "shutdown": "shutting down"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(tt.db)
+			if tt.drain {
+				checker.Drain()
+			}
+
+			code, resp := probe(t, checker.Readiness, "/readyz")
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.checks, resp.Checks)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, StatusOK, resp.Status)
+			} else {
+				assert.Equal(t, StatusUnavailable, resp.Status)
+			}
+		})
+	}
+}
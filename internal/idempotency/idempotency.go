@@ -0,0 +1,113 @@
+// Package idempotency lets clients retry state-changing requests safely: the first response
+// to a request with an Idempotency-Key is stored and replayed for every retry with the same key.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/basedalex/merch-shop/internal/logging"
+)
+
+var logger = logging.Logger("idempotency")
+
+const (
+	// Header carries the key the client picked for the request.
+	Header = "Idempotency-Key"
+	// ReplayedHeader marks a response replayed from the store.
+	ReplayedHeader = "Idempotent-Replayed"
+	// MaxKeyLength bounds the keys clients can use.
+	MaxKeyLength = 255
+)
+
+var (
+	ErrNotFound = errors.New("idempotency key not found")
+	// ErrKeyTaken is returned by a ledger change whose key was stored by a concurrent request.
+	ErrKeyTaken = errors.New("idempotency key is already used")
+)
+
+// Record is the response stored for a key. Keys are scoped to the principal that used them,
+// and the fingerprint tells a retry from a different request reusing the key.
+type Record struct {
+	Principal   string
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Store keeps the records. Save must not overwrite a record that has not expired yet.
+type Store interface {
+	GetIdempotencyRecord(ctx context.Context, principal, key string) (*Record, error)
+	SaveIdempotencyRecord(ctx context.Context, record Record) error
+	PurgeIdempotencyRecords(ctx context.Context) error
+}
+
+// Fingerprint identifies a request by route and body.
+func Fingerprint(route string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(route))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type pendingKey struct{}
+
+// pending is the record of the request in flight, without a response until Prepare.
+type pending struct {
+	record   Record
+	prepared bool
+}
+
+// WithPending marks the request as carrying a key; record holds everything but the response.
+func WithPending(ctx context.Context, record Record) context.Context {
+	return context.WithValue(ctx, pendingKey{}, &pending{record: record})
+}
+
+// Prepare sets the response a ledger change stores under the key of the request in its own
+// transaction, so the change and the record commit together. It does nothing without a key.
+func Prepare(ctx context.Context, statusCode int, contentType string, body []byte) {
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok {
+		return
+	}
+
+	p.record.StatusCode, p.record.ContentType, p.record.Body = statusCode, contentType, body
+	p.prepared = true
+}
+
+// Prepared returns the record a ledger change has to store, nil when there is none.
+func Prepared(ctx context.Context) *Record {
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok || !p.prepared {
+		return nil
+	}
+
+	record := p.record
+
+	return &record
+}
+
+// Purge drops expired records every interval until ctx is done.
+func Purge(ctx context.Context, store Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.PurgeIdempotencyRecords(ctx); err != nil {
+				logger.Warn(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package identity authenticates employees against the sources the service trusts:
+// the local password database and an external OpenID Connect issuer.
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// LocalIssuer is the issuer of identities proven with a local password.
+const LocalIssuer = "local"
+
+var (
+	ErrInvalidCredentials = errors.New("credentials are incorrect")
+	ErrUnknownEmployee    = errors.New("employee not found")
+)
+
+// Identity is who a provider has authenticated. Subject is stable within the Issuer;
+// Username is the employee name the provider claims, used to link a new subject.
+type Identity struct {
+	Issuer   string
+	Subject  string
+	Username string
+}
+
+// Provider is a source of employee identities.
+type Provider interface {
+	Name() string
+}
+
+// PasswordProvider checks credentials the employee sends to the service itself.
+type PasswordProvider interface {
+	Provider
+	Authenticate(ctx context.Context, username, password string) (*Identity, error)
+}
+
+// RedirectProvider authenticates the employee on the provider's own pages and
+// redirects them back with a code the service exchanges for their identity.
+type RedirectProvider interface {
+	Provider
+	AuthCodeURL(login Login) string
+	Exchange(ctx context.Context, code string, login Login) (*Identity, error)
+}
+
+// Login holds the secrets of one redirect login. State ties the callback to the browser
+// that started the login, Nonce ties the ID token to it, Verifier is the PKCE code verifier.
+type Login struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+func NewLogin() (*Login, error) {
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Login{State: state, Nonce: nonce, Verifier: oauth2.GenerateVerifier()}, nil
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating login secret: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
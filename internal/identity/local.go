@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/basedalex/merch-shop/internal/swagger"
+)
+
+// LocalStore checks passwords against the employees table.
+type LocalStore interface {
+	Authenticate(ctx context.Context, authRequest api.AuthRequest) (bool, error)
+}
+
+// Local authenticates employees with the bcrypt hashes stored by the service.
+type Local struct {
+	store LocalStore
+}
+
+func NewLocal(store LocalStore) *Local {
+	return &Local{store: store}
+}
+
+func (l *Local) Name() string {
+	return LocalIssuer
+}
+
+// Authenticate returns ErrUnknownEmployee for a username that does not exist
+// and ErrInvalidCredentials for a wrong password.
+func (l *Local) Authenticate(ctx context.Context, username, password string) (*Identity, error) {
+	exists, err := l.store.Authenticate(ctx, api.AuthRequest{Username: username, Password: password})
+	if err != nil && exists {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUnknownEmployee
+	}
+
+	return &Identity{Issuer: LocalIssuer, Subject: username, Username: username}, nil
+}
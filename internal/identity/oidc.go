@@ -0,0 +1,99 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+const defaultUsernameClaim = "preferred_username"
+
+// OIDC logs employees in with the authorization code flow of an OpenID Connect issuer.
+// Codes are bound to the login with PKCE, ID tokens are checked for signature, issuer,
+// audience, expiry and nonce.
+type OIDC struct {
+	oauth2        oauth2.Config
+	verifier      *oidc.IDTokenVerifier
+	usernameClaim string
+}
+
+// NewOIDC reads the issuer's discovery document, so the issuer has to be reachable.
+func NewOIDC(ctx context.Context, cfg config.OIDCProvider) (*OIDC, error) {
+	provider, err := oidc.NewProvider(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering oidc issuer %q: %w", cfg.Issuer, err)
+	}
+
+	scopes := cfg.Scopes
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		scopes = append([]string{oidc.ScopeOpenID}, scopes...)
+	}
+
+	usernameClaim := cfg.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+
+	return &OIDC{
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+		},
+		verifier:      provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		usernameClaim: usernameClaim,
+	}, nil
+}
+
+func (o *OIDC) Name() string {
+	return "oidc"
+}
+
+func (o *OIDC) AuthCodeURL(login Login) string {
+	return o.oauth2.AuthCodeURL(login.State, oidc.Nonce(login.Nonce), oauth2.S256ChallengeOption(login.Verifier))
+}
+
+// Exchange redeems the code and returns the identity from the verified ID token.
+// Errors caused by the code or the token wrap ErrInvalidCredentials.
+func (o *OIDC) Exchange(ctx context.Context, code string, login Login) (*Identity, error) {
+	token, err := o.oauth2.Exchange(ctx, code, oauth2.VerifierOption(login.Verifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: error exchanging code: %w", ErrInvalidCredentials, err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: token response has no id_token", ErrInvalidCredentials)
+	}
+
+	idToken, err := o.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	if idToken.Nonce != login.Nonce {
+		return nil, fmt.Errorf("%w: id token nonce does not match", ErrInvalidCredentials)
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("error reading id token claims: %w", err)
+	}
+
+	// an unverified address could belong to anyone, so it is not used to link accounts
+	username, _ := claims[o.usernameClaim].(string)
+	if o.usernameClaim == "email" {
+		if verified, _ := claims["email_verified"].(bool); !verified {
+			username = ""
+		}
+	}
+
+	return &Identity{Issuer: idToken.Issuer, Subject: idToken.Subject, Username: username}, nil
+}
@@ -0,0 +1,258 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+// stubIssuer is a minimal OpenID Connect provider: discovery, JWKS and a token endpoint
+// that redeems codes handed out by authorize.
+type stubIssuer struct {
+	*httptest.Server
+
+	t      *testing.T
+	signer jose.Signer
+	key    *rsa.PrivateKey
+
+	// pending maps an issued code to the PKCE challenge and the ID token claims it redeems for
+	pending map[string]stubGrant
+}
+
+type stubGrant struct {
+	challenge string
+	claims    map[string]interface{}
+}
+
+func newStubIssuer(t *testing.T) *stubIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: key, KeyID: "stub"},
+	}, nil)
+	require.NoError(t, err)
+
+	s := &stubIssuer{t: t, signer: signer, key: key, pending: map[string]stubGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                s.URL,
+			"authorization_endpoint":                s.URL + "/authorize",
+			"token_endpoint":                        s.URL + "/token",
+			"jwks_uri":                              s.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "stub", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", s.token)
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// authorize plays the part of the employee logging in on the provider's page.
+func (s *stubIssuer) authorize(authURL string, claims map[string]interface{}) string {
+	u, err := url.Parse(authURL)
+	require.NoError(s.t, err)
+
+	q := u.Query()
+	assert.Equal(s.t, "S256", q.Get("code_challenge_method"))
+
+	if _, ok := claims["nonce"]; !ok {
+		claims["nonce"] = q.Get("nonce")
+	}
+
+	code := "code-" + q.Get("state")
+	s.pending[code] = stubGrant{challenge: q.Get("code_challenge"), claims: claims}
+
+	return code
+}
+
+func (s *stubIssuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	grant, ok := s.pending[r.PostForm.Get("code")]
+	delete(s.pending, r.PostForm.Get("code"))
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+
+		return
+	}
+
+	payload, err := json.Marshal(grant.claims)
+	require.NoError(s.t, err)
+
+	signed, err := s.signer.Sign(payload)
+	require.NoError(s.t, err)
+
+	idToken, err := signed.CompactSerialize()
+	require.NoError(s.t, err)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "stub-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (s *stubIssuer) claims(subject string) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":                s.URL,
+		"sub":                subject,
+		"aud":                "merch-shop",
+		"iat":                time.Now().Unix(),
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+	}
+}
+
+func newTestOIDC(t *testing.T, issuer *stubIssuer, usernameClaim string) *OIDC {
+	provider, err := NewOIDC(context.Background(), config.OIDCProvider{
+		Issuer:        issuer.URL,
+		ClientID:      "merch-shop",
+		ClientSecret:  "secret",
+		RedirectURL:   "http://shop.test/api/auth/oidc/callback",
+		Scopes:        []string{"profile"},
+		UsernameClaim: usernameClaim,
+	})
+	require.NoError(t, err)
+
+	return provider
+}
+
+func TestOIDCLogin(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDC(t, issuer, "")
+
+	login, err := NewLogin()
+	require.NoError(t, err)
+
+	authURL := provider.AuthCodeURL(*login)
+	q, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, login.State, q.Query().Get("state"))
+	assert.Equal(t, "openid profile", q.Query().Get("scope"))
+	assert.NotContains(t, authURL, login.Verifier, "only the challenge leaves the service")
+
+	code := issuer.authorize(authURL, issuer.claims("sso-42"))
+
+	id, err := provider.Exchange(context.Background(), code, *login)
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{Issuer: issuer.URL, Subject: "sso-42", Username: "alice"}, id)
+
+	_, err = provider.Exchange(context.Background(), code, *login)
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "codes are single-use")
+}
+
+func TestOIDCRejects(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDC(t, issuer, "")
+
+	tests := []struct {
+		name   string
+		claims func(map[string]interface{})
+		login  func(*Login)
+	}{
+		{
+			name:  "Wrong code verifier",
+			login: func(l *Login) { l.Verifier = "intercepted-code-used-by-someone-else-0123456789" },
+		},
+		{
+			name:  "Replayed ID token",
+			login: func(l *Login) { l.Nonce = "another-login" },
+		},
+		{
+			name:   "Token for another client",
+			claims: func(c map[string]interface{}) { c["aud"] = "other-app" },
+		},
+		{
+			name:   "Expired token",
+			claims: func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		},
+		{
+			name:   "Token from another issuer",
+			claims: func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			login, err := NewLogin()
+			require.NoError(t, err)
+
+			claims := issuer.claims("sso-42")
+			if tt.claims != nil {
+				tt.claims(claims)
+			}
+
+			code := issuer.authorize(provider.AuthCodeURL(*login), claims)
+
+			if tt.login != nil {
+				tt.login(login)
+			}
+
+			_, err = provider.Exchange(context.Background(), code, *login)
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		})
+	}
+}
+
+func TestOIDCUnverifiedEmail(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDC(t, issuer, "email")
+
+	for verified, username := range map[bool]string{true: "alice@example.com", false: ""} {
+		login, err := NewLogin()
+		require.NoError(t, err)
+
+		claims := issuer.claims("sso-42")
+		claims["email_verified"] = verified
+
+		code := issuer.authorize(provider.AuthCodeURL(*login), claims)
+
+		id, err := provider.Exchange(context.Background(), code, *login)
+		require.NoError(t, err)
+		assert.Equal(t, username, id.Username)
+	}
+}
+
+func TestNewOIDCIssuerMismatch(t *testing.T) {
+	issuer := newStubIssuer(t)
+
+	_, err := NewOIDC(context.Background(), config.OIDCProvider{Issuer: issuer.URL + "/"})
+	assert.Error(t, err)
+}
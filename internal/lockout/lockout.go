@@ -0,0 +1,162 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/basedalex/merch-shop/internal/config"
+	"github.com/basedalex/merch-shop/internal/db"
+	"github.com/basedalex/merch-shop/internal/logging"
+)
+
+var logger = logging.Logger("lockout")
+
+// defaultMaxDelay caps the backoff when the policy sets no MaxDelay.
+const defaultMaxDelay = 24 * time.Hour
+
+type Store interface {
+	GetBlockedUntil(ctx context.Context, keys []string) (time.Time, error)
+	RecordAuthFailure(ctx context.Context, key string, resetAfter time.Duration) (int, error)
+	BlockAuthKey(ctx context.Context, key string, until time.Time) error
+	LockAuthKey(ctx context.Context, event db.LockoutEvent) error
+	ResetAuthFailures(ctx context.Context, key string) error
+}
+
+// Guard throttles password guessing per username and per client IP.
+type Guard struct {
+	store Store
+
+	mu       sync.RWMutex
+	username config.LockoutPolicy
+	ip       config.LockoutPolicy
+}
+
+func New(store Store, username, ip config.LockoutPolicy) *Guard {
+	return &Guard{store: store, username: username, ip: ip}
+}
+
+// SetPolicies replaces the policies on a configuration reload. Failures already
+// recorded are judged by the new policies from the next attempt on.
+func (g *Guard) SetPolicies(username, ip config.LockoutPolicy) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.username, g.ip = username, ip
+}
+
+func (g *Guard) policies() (username, ip config.LockoutPolicy) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.username, g.ip
+}
+
+func UsernameKey(username string) string {
+	return "username:" + username
+}
+
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Check returns how long the client has to wait before the next attempt, zero if it may try now.
+// It runs before the password hash is compared, so blocked attempts cost no bcrypt work.
+func (g *Guard) Check(ctx context.Context, username, ip string) (time.Duration, error) {
+	usernamePolicy, ipPolicy := g.policies()
+	if !enabled(usernamePolicy) && !enabled(ipPolicy) {
+		return 0, nil
+	}
+
+	blockedUntil, err := g.store.GetBlockedUntil(ctx, []string{UsernameKey(username), IPKey(ip)})
+	if err != nil {
+		return 0, err
+	}
+
+	if blockedUntil.IsZero() {
+		return 0, nil
+	}
+
+	return time.Until(blockedUntil), nil
+}
+
+// Fail records a failed attempt for both keys and blocks them according to their policies.
+func (g *Guard) Fail(ctx context.Context, username, ip string) error {
+	usernamePolicy, ipPolicy := g.policies()
+	if err := g.fail(ctx, UsernameKey(username), usernamePolicy); err != nil {
+		return err
+	}
+
+	return g.fail(ctx, IPKey(ip), ipPolicy)
+}
+
+// Succeed forgets the failures of the username. The IP counter is kept,
+// otherwise one valid account would let an attacker reset it at will.
+func (g *Guard) Succeed(ctx context.Context, username string) error {
+	if usernamePolicy, _ := g.policies(); !enabled(usernamePolicy) {
+		return nil
+	}
+
+	return g.store.ResetAuthFailures(ctx, UsernameKey(username))
+}
+
+func (g *Guard) fail(ctx context.Context, key string, policy config.LockoutPolicy) error {
+	if !enabled(policy) {
+		return nil
+	}
+
+	failures, err := g.store.RecordAuthFailure(ctx, key, policy.ResetAfter)
+	if err != nil {
+		return err
+	}
+
+	delay, locked := Delay(policy, failures)
+	if delay <= 0 {
+		return nil
+	}
+
+	until := time.Now().Add(delay)
+
+	if !locked {
+		return g.store.BlockAuthKey(ctx, key, until)
+	}
+
+	logger.WithFields(log.Fields{"key": key, "failures": failures, "until": until}).Warn("login locked out")
+
+	if err := g.store.LockAuthKey(ctx, db.LockoutEvent{Key: key, Failures: failures, LockedUntil: until}); err != nil {
+		return fmt.Errorf("error locking out %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Delay returns how long a key has to wait after its n-th consecutive failure
+// and whether that wait is a lockout rather than a backoff.
+func Delay(policy config.LockoutPolicy, failures int) (time.Duration, bool) {
+	if policy.LockoutThreshold > 0 && failures >= policy.LockoutThreshold {
+		return policy.LockoutDuration, true
+	}
+
+	if policy.BaseDelay <= 0 || failures <= policy.FreeAttempts {
+		return 0, false
+	}
+
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxDelay
+	}
+
+	delay := policy.BaseDelay
+	for i := policy.FreeAttempts + 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay), false
+}
+
+func enabled(policy config.LockoutPolicy) bool {
+	return policy.BaseDelay > 0 || policy.LockoutThreshold > 0
+}
@@ -0,0 +1,46 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+func TestDelay(t *testing.T) {
+	policy := config.LockoutPolicy{
+		FreeAttempts:     3,
+		BaseDelay:        time.Second,
+		MaxDelay:         10 * time.Second,
+		LockoutThreshold: 10,
+		LockoutDuration:  time.Hour,
+	}
+
+	tests := []struct {
+		failures int
+		delay    time.Duration
+		locked   bool
+	}{
+		{failures: 1, delay: 0},
+		{failures: 3, delay: 0},
+		{failures: 4, delay: time.Second},
+		{failures: 5, delay: 2 * time.Second},
+		{failures: 7, delay: 8 * time.Second},
+		{failures: 8, delay: 10 * time.Second},
+		{failures: 10, delay: time.Hour, locked: true},
+	}
+
+	for _, tt := range tests {
+		delay, locked := Delay(policy, tt.failures)
+		assert.Equal(t, tt.delay, delay, "failures=%d", tt.failures)
+		assert.Equal(t, tt.locked, locked, "failures=%d", tt.failures)
+	}
+}
+
+func TestDelayDisabled(t *testing.T) {
+	delay, locked := Delay(config.LockoutPolicy{}, 100)
+	assert.Zero(t, delay)
+	assert.False(t, locked)
+}
@@ -0,0 +1,209 @@
+// Package logging applies the log section of the configuration to logrus: level, format,
+// output with rotation, and per-package levels.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+const (
+	FormatJSON = config.LogFormatJSON
+	FormatText = config.LogFormatText
+)
+
+var (
+	mu      sync.Mutex
+	loggers = map[string]*log.Logger{}
+
+	// the settings applied by the last Setup, used for loggers created after it
+	out       io.Writer = os.Stderr
+	file      *lumberjack.Logger
+	formatter log.Formatter = &log.JSONFormatter{}
+	level                   = log.InfoLevel
+	overrides               = map[string]log.Level{}
+)
+
+// Logger returns the logger of a package. It shares the output and format of the standard
+// logger, its level can be overridden in log.packages. It follows every later Setup,
+// so packages can keep it in a package variable.
+func Logger(pkg string) *log.Logger {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if l, ok := loggers[pkg]; ok {
+		return l
+	}
+
+	l := log.New()
+	l.AddHook(traceHook{})
+	apply(l, pkg)
+	loggers[pkg] = l
+
+	return l
+}
+
+func init() {
+	log.AddHook(traceHook{})
+}
+
+// Setup applies cfg to the standard logger and the package loggers. On error nothing is changed.
+func Setup(cfg config.Log) error {
+	newLevel := log.InfoLevel
+	if cfg.Level != "" {
+		lvl, err := log.ParseLevel(cfg.Level)
+		if err != nil {
+			return fmt.Errorf("error parsing log level: %w", err)
+		}
+
+		newLevel = lvl
+	}
+
+	newOverrides := make(map[string]log.Level, len(cfg.Packages))
+	for pkg, name := range cfg.Packages {
+		lvl, err := log.ParseLevel(name)
+		if err != nil {
+			return fmt.Errorf("error parsing log level of package %q: %w", pkg, err)
+		}
+
+		newOverrides[pkg] = lvl
+	}
+
+	var newFormatter log.Formatter
+	switch cfg.Format {
+	case FormatJSON, "":
+		newFormatter = &log.JSONFormatter{}
+	case FormatText:
+		newFormatter = &log.TextFormatter{FullTimestamp: true}
+	default:
+		return fmt.Errorf("unknown log format %q", cfg.Format)
+	}
+
+	var (
+		newOut  io.Writer
+		newFile *lumberjack.Logger
+	)
+
+	switch cfg.Output {
+	case "stderr", "":
+		newOut = os.Stderr
+	case "stdout":
+		newOut = os.Stdout
+	default:
+		newFile = &lumberjack.Logger{
+			Filename:   cfg.Output,
+			MaxSize:    cfg.Rotation.MaxSizeMB,
+			MaxAge:     cfg.Rotation.MaxAgeDays,
+			MaxBackups: cfg.Rotation.MaxBackups,
+			Compress:   cfg.Rotation.Compress,
+		}
+		newOut = newFile
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	previous := file
+	out, file, formatter, level, overrides = newOut, newFile, newFormatter, newLevel, newOverrides
+
+	log.SetOutput(out)
+	log.SetFormatter(formatter)
+	log.SetLevel(level)
+
+	for pkg, l := range loggers {
+		apply(l, pkg)
+	}
+
+	if previous != nil {
+		if err := previous.Close(); err != nil {
+			log.Warn(fmt.Errorf("error closing log file: %w", err))
+		}
+	}
+
+	return nil
+}
+
+func apply(l *log.Logger, pkg string) {
+	l.SetOutput(out)
+	l.SetFormatter(formatter)
+
+	if lvl, ok := overrides[pkg]; ok {
+		l.SetLevel(lvl)
+	} else {
+		l.SetLevel(level)
+	}
+}
+
+// Reopen closes the log file, the next line opens it again. After an external tool
+// has moved the file away this starts a new one under the configured name.
+func Reopen() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if file == nil {
+		return nil
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error closing log file: %w", err)
+	}
+
+	return nil
+}
+
+// ReopenOnSIGHUP reopens the log file on every SIGHUP until ctx is done.
+func ReopenOnSIGHUP(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			if err := Reopen(); err != nil {
+				log.Error(err)
+
+				continue
+			}
+
+			log.Info("log file reopened")
+		}
+	}
+}
+
+// traceHook adds the IDs of the current span to lines logged with WithContext,
+// so a log line can be found from a trace and the other way round.
+type traceHook struct{}
+
+func (traceHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (traceHook) Fire(entry *log.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+
+	span := trace.SpanContextFromContext(entry.Context)
+	if !span.IsValid() {
+		return nil
+	}
+
+	entry.Data["trace_id"] = span.TraceID().String()
+	entry.Data["span_id"] = span.SpanID().String()
+
+	return nil
+}
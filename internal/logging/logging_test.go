@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+func resetLogging(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, Setup(config.Log{}))
+	})
+}
+
+func TestPackageLevels(t *testing.T) {
+	resetLogging(t)
+
+	early := Logger("db")
+
+	require.NoError(t, Setup(config.Log{
+		Level:    "warn",
+		Packages: map[string]string{"db": "debug", "late": "error"},
+	}))
+
+	assert.True(t, early.IsLevelEnabled(log.DebugLevel), "loggers created before Setup follow it")
+	assert.False(t, Logger("service").IsLevelEnabled(log.InfoLevel))
+	assert.True(t, Logger("service").IsLevelEnabled(log.WarnLevel))
+	assert.False(t, Logger("late").IsLevelEnabled(log.WarnLevel))
+	assert.Equal(t, log.WarnLevel, log.GetLevel())
+}
+
+func TestSetupRejectsInvalidConfig(t *testing.T) {
+	resetLogging(t)
+
+	require.NoError(t, Setup(config.Log{Level: "debug"}))
+
+	for _, cfg := range []config.Log{
+		{Level: "loud"},
+		{Packages: map[string]string{"db": "verbose"}},
+		{Format: "xml"},
+	} {
+		assert.Error(t, Setup(cfg))
+	}
+
+	assert.Equal(t, log.DebugLevel, log.GetLevel(), "a failed Setup keeps the previous settings")
+}
+
+func TestFileOutputReopen(t *testing.T) {
+	resetLogging(t)
+
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+
+	require.NoError(t, Setup(config.Log{Output: path, Format: FormatText}))
+
+	logger := Logger("service")
+	logger.Info("before rotation")
+
+	// an external tool such as logrotate moves the file away and sends SIGHUP
+	require.NoError(t, os.Rename(path, path+".1"))
+	require.NoError(t, Reopen())
+
+	logger.Info("after rotation")
+
+	rotated, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	assert.Contains(t, string(rotated), "before rotation")
+	assert.NotContains(t, string(rotated), "after rotation")
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(current), "after rotation")
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapeTimeout bounds the queries run while Prometheus waits for the metrics.
+const scrapeTimeout = 3 * time.Second
+
+// DBSource is what the database collector reads: the connection pool statistics
+// and the coins held by all employees together.
+type DBSource interface {
+	Stat() *pgxpool.Stat
+	CoinSupply(ctx context.Context) (int64, error)
+}
+
+// DBCollector reports pool statistics and the coin supply at scrape time.
+type DBCollector struct {
+	source DBSource
+
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	totalConns      *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquires        *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquires   *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+	coinSupply      *prometheus.Desc
+	scrapeErrors    *prometheus.Desc
+}
+
+func NewDBCollector(source DBSource) *DBCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, nil, nil)
+	}
+
+	return &DBCollector{
+		source:          source,
+		acquiredConns:   desc("db_pool_acquired_conns", "Connections currently in use."),
+		idleConns:       desc("db_pool_idle_conns", "Idle connections in the pool."),
+		totalConns:      desc("db_pool_total_conns", "All open connections in the pool."),
+		maxConns:        desc("db_pool_max_conns", "Maximum size of the pool."),
+		acquires:        desc("db_pool_acquires_total", "Connections acquired from the pool."),
+		acquireDuration: desc("db_pool_acquire_duration_seconds_total", "Time spent waiting for a connection."),
+		emptyAcquires:   desc("db_pool_empty_acquires_total", "Acquires that had to wait because the pool was empty."),
+		canceledAcquire: desc("db_pool_canceled_acquires_total", "Acquires canceled by their context."),
+		coinSupply:      desc("coin_supply", "Coins held by all employees together."),
+		scrapeErrors:    desc("db_scrape_errors", "1 if the last scrape could not read the coin supply."),
+	}
+}
+
+// Describe lists the metrics without collecting them, so registering the collector does not query the database.
+func (c *DBCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquires
+	ch <- c.canceledAcquire
+	ch <- c.coinSupply
+	ch <- c.scrapeErrors
+}
+
+func (c *DBCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.source.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	supply, err := c.source.CoinSupply(ctx)
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(c.scrapeErrors, prometheus.GaugeValue, 1)
+
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.scrapeErrors, prometheus.GaugeValue, 0)
+	ch <- prometheus.MustNewConstMetric(c.coinSupply, prometheus.GaugeValue, float64(supply))
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB reports the statistics of a pool that never connects.
+type fakeDB struct {
+	pool   *pgxpool.Pool
+	supply int64
+	err    error
+}
+
+func (f fakeDB) Stat() *pgxpool.Stat {
+	return f.pool.Stat()
+}
+
+func (f fakeDB) CoinSupply(_ context.Context) (int64, error) {
+	return f.supply, f.err
+}
+
+func newIdlePool(t *testing.T) *pgxpool.Pool {
+	pool, err := pgxpool.New(context.Background(), "postgres://metrics@127.0.0.1:1/none")
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestDBCollector(t *testing.T) {
+	pool := newIdlePool(t)
+
+	expected := `
+# HELP merchshop_coin_supply Coins held by all employees together.
+# TYPE merchshop_coin_supply gauge
+merchshop_coin_supply 12500
+# HELP merchshop_db_scrape_errors 1 if the last scrape could not read the coin supply.
+# TYPE merchshop_db_scrape_errors gauge
+merchshop_db_scrape_errors 0
+`
+	err := testutil.CollectAndCompare(NewDBCollector(fakeDB{pool: pool, supply: 12500}), strings.NewReader(expected),
+		"merchshop_coin_supply", "merchshop_db_scrape_errors")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 10, testutil.CollectAndCount(NewDBCollector(fakeDB{pool: pool, supply: 12500})))
+}
+
+func TestDBCollectorScrapeError(t *testing.T) {
+	expected := `
+# HELP merchshop_db_scrape_errors 1 if the last scrape could not read the coin supply.
+# TYPE merchshop_db_scrape_errors gauge
+merchshop_db_scrape_errors 1
+`
+	collector := NewDBCollector(fakeDB{pool: newIdlePool(t), err: errors.New("connection refused")})
+
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), "merchshop_db_scrape_errors"))
+	assert.Equal(t, 0, testutil.CollectAndCount(collector, "merchshop_coin_supply"), "no stale supply on errors")
+}
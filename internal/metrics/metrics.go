@@ -0,0 +1,89 @@
+// Package metrics defines the Prometheus metrics of the service and the registry
+// served on the admin port.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "merchshop"
+
+// Registry holds every metric of the service, including Go runtime and process metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method and route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	Purchases = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "purchases_total",
+		Help:      "Merch bought, by item.",
+	}, []string{"item"})
+
+	CoinsTransferred = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coins_transferred_total",
+		Help:      "Coins sent between employees.",
+	})
+
+	FailedTransfers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_failed_total",
+		Help:      "Coin transfers that were refused or failed, by reason.",
+	}, []string{"reason"})
+
+	AuthFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "auth_failures_total",
+		Help:      "Failed authentication attempts by factor (password, mfa, api_key).",
+	}, []string{"factor"})
+
+	RateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_total",
+		Help:      "Requests refused with 429 by the rate limiter, by route.",
+	}, []string{"route"})
+)
+
+// Reasons of FailedTransfers.
+const (
+	ReasonInvalidAmount     = "invalid_amount"
+	ReasonSelfTransfer      = "self_transfer"
+	ReasonUnknownEmployee   = "unknown_employee"
+	ReasonInsufficientFunds = "insufficient_funds"
+	ReasonMFA               = "mfa"
+	ReasonError             = "error"
+)
+
+// Factors of AuthFailures.
+const (
+	FactorPassword = "password"
+	FactorMFA      = "mfa"
+	FactorAPIKey   = "api_key"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPDuration,
+		Purchases,
+		CoinsTransferred,
+		FailedTransfers,
+		AuthFailures,
+		RateLimited,
+	)
+}
@@ -0,0 +1,49 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RecoveryCodeCount is how many single-use recovery codes an enrollment gets.
+const RecoveryCodeCount = 10
+
+// recoveryAlphabet leaves out characters that are easy to misread on paper.
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// NewRecoveryCodes returns codes formatted as xxxxx-xxxxx.
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodeCount)
+
+	for i := range codes {
+		buf := make([]byte, 10)
+
+		for j := range buf {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryAlphabet))))
+			if err != nil {
+				return nil, fmt.Errorf("error generating recovery codes: %w", err)
+			}
+
+			buf[j] = recoveryAlphabet[n.Int64()]
+		}
+
+		codes[i] = string(buf[:5]) + "-" + string(buf[5:])
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode makes a typed-in recovery code comparable to the issued one.
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, " ", "")
+	code = strings.ReplaceAll(code, "-", "")
+
+	if len(code) != 10 {
+		return code
+	}
+
+	return code[:5] + "-" + code[5:]
+}
@@ -0,0 +1,69 @@
+package mfa
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA-1 seed "12345678901234567890" from RFC 6238 appendix B, base32-encoded.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// the RFC lists 8-digit codes, the 6-digit ones are their last six digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := Code(rfc6238Secret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "t=%d", tt.unix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	step, ok := Verify(rfc6238Secret, "005924", now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/30, step)
+
+	_, ok = Verify(rfc6238Secret, "005924", now.Add(Period))
+	assert.True(t, ok, "codes of the previous step are accepted")
+
+	_, ok = Verify(rfc6238Secret, "005924", now.Add(3*Period))
+	assert.False(t, ok)
+
+	_, ok = Verify(rfc6238Secret, "00592", now)
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("merch-shop", "alice", rfc6238Secret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/merch-shop:alice?"))
+	assert.Contains(t, uri, "secret="+rfc6238Secret)
+	assert.Contains(t, uri, "issuer=merch-shop")
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := NewRecoveryCodes()
+	require.NoError(t, err)
+	assert.Len(t, codes, RecoveryCodeCount)
+
+	for _, code := range codes {
+		assert.Len(t, code, 11)
+		assert.Equal(t, code, NormalizeRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", " "))))
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/problem"
+)
+
+// Policy maps a route ("METHOD /pattern" as registered in chi) to the roles allowed to call it.
+// Routes missing from the policy are open to every authenticated employee.
+type Policy map[string][]auth.Role
+
+// ScopePolicy maps a route to the API key scope it needs. Service accounts can only call
+// the routes listed here; employees calling them are checked against Policy as usual.
+type ScopePolicy map[string]auth.Scope
+
+// Authorize enforces the policies. It has to run after the router has matched the route,
+// so it is registered as a per-route middleware through api.ChiServerOptions.
+func Authorize(policy Policy, scopes ScopePolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+
+			if account, ok := auth.ServiceAccountFromContext(r.Context()); ok {
+				scope, ok := scopes[route]
+				if !ok || !account.HasScope(scope) {
+					problem.Write(w, r, problem.New(r, http.StatusForbidden, problem.Forbidden, "the api key lacks the scope of the route"))
+					return
+				}
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			roles, ok := policy[route]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, ok := auth.ClaimsFromContext(r.Context())
+			if !ok {
+				problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "missing authorization header"))
+				return
+			}
+
+			for _, role := range roles {
+				if claims.Role == role {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			problem.Write(w, r, problem.New(r, http.StatusForbidden, problem.Forbidden, "the role is not allowed to call the route"))
+		})
+	}
+}
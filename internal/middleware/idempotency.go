@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/basedalex/merch-shop/internal/idempotency"
+	"github.com/basedalex/merch-shop/internal/problem"
+)
+
+// RouteSet is a set of routes ("METHOD /pattern" as registered in chi).
+type RouteSet map[string]bool
+
+// Idempotency replays the stored response when an authenticated client retries a request
+// with the same Idempotency-Key, and refuses the key with 422 for a request with another
+// route or body. It covers only the routes in routes: responses are stored as they are, so
+// routes that answer with secrets must stay out of it. Server errors are not stored, so the
+// request can be retried. Like Authorize it needs the matched route, so it is registered
+// through api.ChiServerOptions.
+func Idempotency(store idempotency.Store, ttl time.Duration, routes RouteSet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotency.Header)
+			route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+			principal := principalKey(r)
+
+			if key == "" || principal == "" || !routes[route] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > idempotency.MaxKeyLength {
+				detail := fmt.Sprintf("idempotency key must not be longer than %d characters", idempotency.MaxKeyLength)
+				problem.Write(w, r, problem.New(r, http.StatusBadRequest, problem.BadRequest, detail))
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				problem.Write(w, r, problem.New(r, http.StatusBadRequest, problem.BadRequest, "error reading request body"))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			fingerprint := idempotency.Fingerprint(route, body)
+
+			if replayStored(w, r, store, principal, key, fingerprint) {
+				return
+			}
+
+			record := idempotency.Record{
+				Principal:   principal,
+				Key:         key,
+				Fingerprint: fingerprint,
+				ExpiresAt:   time.Now().Add(ttl),
+			}
+
+			buf := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(buf, r.WithContext(idempotency.WithPending(r.Context(), record)))
+
+			// a concurrent request with the same key may have won the race for it in its ledger transaction
+			if buf.status >= http.StatusInternalServerError && replayStored(w, r, store, principal, key, fingerprint) {
+				return
+			}
+
+			if buf.status < http.StatusInternalServerError {
+				record.StatusCode, record.ContentType, record.Body = buf.status, buf.header.Get("Content-Type"), buf.body.Bytes()
+				if err := store.SaveIdempotencyRecord(r.Context(), record); err != nil {
+					logger.WithContext(r.Context()).Error(fmt.Errorf("error saving idempotency key: %w", err))
+				}
+			}
+
+			buf.flush(w)
+		})
+	}
+}
+
+// replayStored answers the request from the record of the key and reports whether it did.
+func replayStored(w http.ResponseWriter, r *http.Request, store idempotency.Store, principal, key, fingerprint string) bool {
+	stored, err := store.GetIdempotencyRecord(r.Context(), principal, key)
+	switch {
+	case errors.Is(err, idempotency.ErrNotFound):
+		return false
+	case err != nil:
+		logger.WithContext(r.Context()).Error(fmt.Errorf("error fetching idempotency key: %w", err))
+		writeInternalError(w, r)
+		return true
+	case stored.Fingerprint != fingerprint:
+		problem.Write(w, r, problem.New(r, http.StatusUnprocessableEntity, problem.UnprocessableEntity, "idempotency key was already used for a different request"))
+		return true
+	}
+
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	w.Header().Set(idempotency.ReplayedHeader, "true")
+	w.WriteHeader(stored.StatusCode)
+	_, _ = w.Write(stored.Body)
+
+	return true
+}
+
+// bufferedResponse holds the response until it is known whether to send it or a stored one.
+type bufferedResponse struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if !b.wroteHeader {
+		b.status, b.wroteHeader = status, true
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.wroteHeader = true
+
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) flush(w http.ResponseWriter) {
+	for name, values := range b.header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(b.status)
+	_, _ = b.body.WriteTo(w)
+}
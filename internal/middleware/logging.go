@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+// requestLog collects what inner middlewares learn about a request for its access log line.
+type requestLog struct {
+	principal string
+}
+
+type requestLogKey struct{}
+
+// setPrincipal records who made the request once Authentication has verified it.
+func setPrincipal(ctx context.Context, principal string) {
+	if l, ok := ctx.Value(requestLogKey{}).(*requestLog); ok {
+		l.principal = principal
+	}
+}
+
+// AccessLog writes one line per request with its status, latency, route pattern and caller.
+// It has to run inside the router, so the route pattern is known, and before Authentication.
+func AccessLog(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			entry := &requestLog{}
+			rec := &statusRecorder{ResponseWriter: w}
+
+			next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), requestLogKey{}, entry)))
+
+			fields := log.Fields{
+				"request_id": RequestIDFromContext(r.Context()),
+				"method":     r.Method,
+				"path":       r.URL.Path,
+				"status":     rec.Status(),
+				"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+				"bytes":      rec.bytes,
+				"remote_ip":  ClientIP(r),
+			}
+
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				fields["route"] = rctx.RoutePattern()
+			}
+
+			if entry.principal != "" {
+				fields["username"] = entry.principal
+			}
+
+			logger.WithContext(r.Context()).WithFields(fields).Info("request")
+		})
+	}
+}
+
+// statusRecorder remembers the status and size of the response written through it.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status int
+	bytes  int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+
+	n, err := rec.ResponseWriter.Write(b)
+	rec.bytes += n
+
+	return n, err
+}
+
+// Status is the status sent to the client; a handler that wrote nothing sent 200.
+func (rec *statusRecorder) Status() int {
+	if rec.status == 0 {
+		return http.StatusOK
+	}
+
+	return rec.status
+}
+
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -1,34 +1,120 @@
 package middleware
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/logging"
+	"github.com/basedalex/merch-shop/internal/metrics"
+	"github.com/basedalex/merch-shop/internal/problem"
 )
 
-func Authentication(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		path := r.URL.Path
-		if path == "api/auth" || path == "/api/auth" {
-			next.ServeHTTP(w, r)
-			return
-		}
-		tokenString := r.Header.Get("Authorization")
-		authInfo := strings.Split(tokenString, " ")
-		token := authInfo[1]
-		if tokenString == "" {
-			w.WriteHeader(http.StatusUnauthorized)
-			fmt.Fprint(w, "Missing authorization header")
-			return
-		}
+var logger = logging.Logger("middleware")
+
+// publicPaths are served without an access token.
+var publicPaths = map[string]bool{
+	"/api/auth":               true,
+	"/api/auth/refresh":       true,
+	"/api/auth/mfa":           true,
+	"/api/auth/oidc/login":    true,
+	"/api/auth/oidc/callback": true,
+	"/api/register":           true,
+	"/api/password/reset":     true,
+
+	"/.well-known/jwks.json": true,
+
+	"/openapi.json": true,
+	"/openapi.yaml": true,
+	"/docs":         true,
+
+	"/healthz": true,
+	"/readyz":  true,
+}
+
+// publicPrefixes are served without an access token with everything below them: the files of Swagger UI.
+var publicPrefixes = []string{"/docs/"}
+
+// APIKeyHeader carries the key of a service account instead of a Bearer token.
+const APIKeyHeader = "X-API-Key"
+
+type RevocationChecker interface {
+	IsRevoked(claims *auth.Claims) bool
+}
+
+// APIKeyVerifier returns auth.ErrInvalidAPIKey (wrapped) for keys that are unknown, expired or revoked.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (*auth.ServiceAccount, error)
+}
 
-		if err := auth.VerifyToken(token); err != nil {
-			w.WriteHeader(http.StatusUnauthorized)
-			fmt.Fprint(w, "Invalid token", token)
-			return
+func Authentication(denylist RevocationChecker, apiKeys APIKeyVerifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isPublic("/" + strings.TrimPrefix(r.URL.Path, "/")) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if key := r.Header.Get(APIKeyHeader); key != "" {
+				account, err := apiKeys.VerifyAPIKey(r.Context(), key)
+				if errors.Is(err, auth.ErrInvalidAPIKey) {
+					metrics.AuthFailures.WithLabelValues(metrics.FactorAPIKey).Inc()
+					problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "api key is invalid, expired or revoked"))
+					return
+				}
+
+				if err != nil {
+					logger.Error(err)
+					writeInternalError(w, r)
+					return
+				}
+
+				setPrincipal(r.Context(), "service-account:"+account.Name)
+				next.ServeHTTP(w, r.WithContext(auth.WithServiceAccount(r.Context(), account)))
+				return
+			}
+
+			tokenString := r.Header.Get("Authorization")
+			if tokenString == "" {
+				problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "missing authorization header"))
+				return
+			}
+
+			scheme, token, ok := strings.Cut(tokenString, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "malformed authorization header"))
+				return
+			}
+
+			claims, err := auth.ParseToken(token)
+			if err != nil {
+				problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "token is invalid or expired"))
+				return
+			}
+
+			if denylist.IsRevoked(claims) {
+				problem.Write(w, r, problem.New(r, http.StatusUnauthorized, problem.Unauthorized, "token has been revoked"))
+				return
+			}
+
+			setPrincipal(r.Context(), claims.Username)
+			next.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+		})
+	}
+}
+
+func isPublic(path string) bool {
+	if publicPaths[path] {
+		return true
+	}
+
+	for _, prefix := range publicPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
 		}
-		next.ServeHTTP(w, r)
-	})
+	}
+
+	return false
 }
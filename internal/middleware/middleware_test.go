@@ -0,0 +1,488 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/config"
+	"github.com/basedalex/merch-shop/internal/idempotency"
+	"github.com/basedalex/merch-shop/internal/logging"
+	"github.com/basedalex/merch-shop/internal/metrics"
+	"github.com/basedalex/merch-shop/internal/problem"
+	"github.com/basedalex/merch-shop/internal/ratelimit"
+	api "github.com/basedalex/merch-shop/internal/swagger"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+
+	handler := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	t.Run("Assigned", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/info", nil))
+
+		assert.Len(t, seen, 32)
+		assert.Equal(t, seen, w.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("Propagated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+		req.Header.Set(RequestIDHeader, "gateway-1234")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, "gateway-1234", seen)
+		assert.Equal(t, "gateway-1234", w.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("Unsafe ID replaced", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+		req.Header.Set(RequestIDHeader, "forged\" level=error msg=\"hacked")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Len(t, seen, 32)
+	})
+}
+
+func newTestRouter(t *testing.T, handler http.HandlerFunc) (http.Handler, *test.Hook) {
+	logger, hook := test.NewNullLogger()
+
+	r := chi.NewRouter()
+	r.Use(RequestID, AccessLog(logger), Recoverer)
+	r.Use(Authentication(nil, nil))
+	r.Get("/api/auth/oidc/login", handler)
+	r.Get("/api/buy/{item}", handler)
+
+	t.Cleanup(hook.Reset)
+
+	return r, hook
+}
+
+func TestAccessLog(t *testing.T) {
+	router, hook := newTestRouter(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte("short and stout"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, hook.AllEntries(), 1)
+
+	entry := hook.LastEntry()
+	assert.Equal(t, "req-1", entry.Data["request_id"])
+	assert.Equal(t, http.MethodGet, entry.Data["method"])
+	assert.Equal(t, "/api/auth/oidc/login", entry.Data["route"])
+	assert.Equal(t, http.StatusTeapot, entry.Data["status"])
+	assert.Equal(t, len("short and stout"), entry.Data["bytes"])
+	assert.Contains(t, entry.Data, "latency_ms")
+	assert.NotContains(t, entry.Data, "username", "the route is public")
+}
+
+func TestMalformedAuthorizationHeader(t *testing.T) {
+	router, hook := newTestRouter(t, func(_ http.ResponseWriter, _ *http.Request) {
+		t.Fatal("handler must not be reached")
+	})
+
+	for _, header := range []string{"Bearer", "Bearer ", "Basic dXNlcjpwYXNz", "token-without-scheme"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/buy/cup", nil)
+		req.Header.Set("Authorization", header)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, header)
+		assert.Equal(t, problem.Unauthorized.Code, problemCode(t, w), header)
+	}
+
+	assert.Len(t, hook.AllEntries(), 4)
+}
+
+// problemCode checks that the response is a problem and returns its code.
+func problemCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+
+	var details problem.Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &details))
+	assert.Equal(t, w.Code, details.Status)
+
+	return details.Code
+}
+
+func TestAuthorize(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Header.Get("X-Test-Principal") {
+			case "employee":
+				r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{Username: "alice", Role: auth.RoleEmployee}))
+			case "bot":
+				r = r.WithContext(auth.WithServiceAccount(r.Context(), &auth.ServiceAccount{Name: "bot", Scopes: []auth.Scope{auth.ScopeReadReports}}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	r.With(Authorize(
+		Policy{"POST /api/coins/grant": {auth.RoleFinanceAdmin}},
+		ScopePolicy{"POST /api/coins/grant": auth.ScopeCoinsGrant},
+	)).Post("/api/coins/grant", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Fatal("handler must not be reached")
+	})
+
+	for principal, code := range map[string]int{
+		"":         http.StatusUnauthorized,
+		"employee": http.StatusForbidden,
+		"bot":      http.StatusForbidden,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/coins/grant", nil)
+		req.Header.Set("X-Test-Principal", principal)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, code, w.Code, principal)
+		assert.Equal(t, problem.ForStatus(code).Code, problemCode(t, w), principal)
+	}
+}
+
+func TestRecoverer(t *testing.T) {
+	router, hook := newTestRouter(t, func(_ http.ResponseWriter, _ *http.Request) {
+		var items []string
+		_ = items[1]
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil)
+	req.Header.Set(RequestIDHeader, "req-panic")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+
+	var resp problem.Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "req-panic", resp.RequestID)
+	assert.Equal(t, problem.Internal.Code, resp.Code)
+
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, http.StatusInternalServerError, hook.LastEntry().Data["status"], "the access log sees the 500")
+}
+
+func TestMetricsRoutePattern(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Metrics)
+	r.Get("/api/buy/{item}", func(_ http.ResponseWriter, _ *http.Request) {})
+
+	requests := func(route, status string) float64 {
+		return testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues(http.MethodGet, route, status))
+	}
+
+	before := requests("/api/buy/{item}", "200")
+	unmatchedBefore := requests(unmatchedRoute, "404")
+
+	for _, path := range []string{"/api/buy/cup", "/api/buy/socks", "/random/scanner/path"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, before+2, requests("/api/buy/{item}", "200"), "paths are grouped by route pattern")
+	assert.Equal(t, unmatchedBefore+1, requests(unmatchedRoute, "404"))
+}
+
+func TestTracingContinuesTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var logs bytes.Buffer
+
+	logger := logging.Logger("tracing-test")
+	logger.SetOutput(&logs)
+
+	r := chi.NewRouter()
+	r.Use(RequestID, Tracing, AccessLog(logger))
+	r.Get("/api/buy/{item}", func(_ http.ResponseWriter, _ *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/buy/cup", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /api/buy/{item}", spans[0].Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &line))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", line["trace_id"], "log lines carry the trace ID")
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), config.RateLimits{
+		Default: config.RateLimit{Requests: 100, Period: time.Second},
+		Routes:  map[string]config.RateLimit{"GET /api/buy/{item}": {Requests: 2, Period: time.Minute}},
+	})
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if username := r.Header.Get("X-Test-User"); username != "" {
+				r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{Username: username}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	r.With(RateLimit(limiter)).Get("/api/buy/{item}", func(_ http.ResponseWriter, _ *http.Request) {})
+
+	buy := func(username, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/buy/cup", nil)
+		req.RemoteAddr = remoteAddr
+		if username != "" {
+			req.Header.Set("X-Test-User", username)
+		}
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	first := buy("alice", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get(RateLimitLimitHeader))
+	assert.Equal(t, "1", first.Header().Get(RateLimitRemainingHeader))
+
+	assert.Equal(t, http.StatusOK, buy("alice", "10.0.0.2:1234").Code)
+
+	before := testutil.ToFloat64(metrics.RateLimited.WithLabelValues("GET /api/buy/{item}"))
+	refused := buy("alice", "10.0.0.3:1234")
+	assert.Equal(t, http.StatusTooManyRequests, refused.Code)
+	assert.Equal(t, problem.TooManyRequests.Code, problemCode(t, refused))
+	assert.Equal(t, "0", refused.Header().Get(RateLimitRemainingHeader))
+	assert.Equal(t, "30", refused.Header().Get("Retry-After"), "one token every 30 seconds")
+	assert.Equal(t, "60", refused.Header().Get(RateLimitResetHeader))
+	assert.Equal(t, before+1, testutil.ToFloat64(metrics.RateLimited.WithLabelValues("GET /api/buy/{item}")))
+
+	assert.Equal(t, http.StatusOK, buy("bob", "10.0.0.1:1234").Code, "users have buckets of their own")
+	assert.Equal(t, http.StatusOK, buy("", "10.0.0.1:1234").Code, "anonymous clients are keyed by IP")
+	assert.Equal(t, http.StatusOK, buy("", "10.0.0.1:4321").Code)
+	assert.Equal(t, http.StatusTooManyRequests, buy("", "10.0.0.1:5678").Code)
+}
+
+// memoryIdempotencyStore keeps records like Postgres does, but without expiry.
+type memoryIdempotencyStore struct {
+	records map[string]idempotency.Record
+}
+
+func (s *memoryIdempotencyStore) GetIdempotencyRecord(_ context.Context, principal, key string) (*idempotency.Record, error) {
+	record, ok := s.records[principal+" "+key]
+	if !ok {
+		return nil, idempotency.ErrNotFound
+	}
+
+	return &record, nil
+}
+
+func (s *memoryIdempotencyStore) SaveIdempotencyRecord(_ context.Context, record idempotency.Record) error {
+	if _, ok := s.records[record.Principal+" "+record.Key]; !ok {
+		s.records[record.Principal+" "+record.Key] = record
+	}
+
+	return nil
+}
+
+func (s *memoryIdempotencyStore) PurgeIdempotencyRecords(_ context.Context) error {
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	store := &memoryIdempotencyStore{records: map[string]idempotency.Record{}}
+	transfers := 0
+	failNext := false
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if username := r.Header.Get("X-Test-User"); username != "" {
+				r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{Username: username}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	idempotent := Idempotency(store, time.Hour, RouteSet{"POST /api/sendCoin": true})
+	r.With(idempotent).Post("/api/sendCoin", func(w http.ResponseWriter, _ *http.Request) {
+		if failNext {
+			failNext = false
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		transfers++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"transfer":%d}`, transfers)
+	})
+	enrollments := 0
+	r.With(idempotent).Post("/api/mfa/enroll", func(w http.ResponseWriter, _ *http.Request) {
+		enrollments++
+		fmt.Fprintf(w, `{"secret":"s%d"}`, enrollments)
+	})
+
+	send := func(username, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewBufferString(body))
+		req.Header.Set(idempotency.Header, key)
+		if username != "" {
+			req.Header.Set("X-Test-User", username)
+		}
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	first := send("alice", "k1", `{"toUser":"bob","amount":10}`)
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, `{"transfer":1}`, first.Body.String())
+
+	retry := send("alice", "k1", `{"toUser":"bob","amount":10}`)
+	assert.Equal(t, http.StatusOK, retry.Code)
+	assert.Equal(t, `{"transfer":1}`, retry.Body.String(), "the retry gets the first response")
+	assert.Equal(t, "true", retry.Header().Get(idempotency.ReplayedHeader))
+	assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+	assert.Equal(t, 1, transfers)
+
+	reused := send("alice", "k1", `{"toUser":"bob","amount":20}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+	assert.Equal(t, problem.UnprocessableEntity.Code, problemCode(t, reused))
+
+	assert.Equal(t, `{"transfer":2}`, send("bob", "k1", `{"toUser":"alice","amount":10}`).Body.String(), "keys are scoped to the user")
+
+	failNext = true
+	assert.Equal(t, http.StatusInternalServerError, send("alice", "k2", `{}`).Code)
+	assert.Equal(t, `{"transfer":3}`, send("alice", "k2", `{}`).Body.String(), "server errors can be retried")
+
+	assert.Equal(t, `{"transfer":4}`, send("", "k3", `{}`).Body.String())
+	assert.Equal(t, `{"transfer":5}`, send("", "k3", `{}`).Body.String(), "anonymous requests are not deduplicated")
+
+	assert.Equal(t, http.StatusBadRequest, send("alice", strings.Repeat("k", idempotency.MaxKeyLength+1), `{}`).Code)
+
+	stored := len(store.records)
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/api/mfa/enroll", nil)
+		req.Header.Set(idempotency.Header, "k4")
+		req.Header.Set("X-Test-User", "alice")
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	assert.Equal(t, 2, enrollments, "routes outside the set are not deduplicated")
+	assert.Len(t, store.records, stored, "and their responses are not stored")
+}
+
+func TestIdempotencyPrepare(t *testing.T) {
+	ctx := context.Background()
+	idempotency.Prepare(ctx, http.StatusOK, "application/json", []byte("{}"))
+	assert.Nil(t, idempotency.Prepared(ctx), "requests without a key store nothing")
+
+	ctx = idempotency.WithPending(ctx, idempotency.Record{Principal: "user:alice", Key: "k1", Fingerprint: "f"})
+	assert.Nil(t, idempotency.Prepared(ctx))
+
+	idempotency.Prepare(ctx, http.StatusOK, "application/json", []byte("{}"))
+	record := idempotency.Prepared(ctx)
+	require.NotNil(t, record)
+	assert.Equal(t, "k1", record.Key)
+	assert.Equal(t, []byte("{}"), record.Body)
+
+	assert.NotEqual(t, idempotency.Fingerprint("POST /api/sendCoin", []byte(`{"amount":1}`)),
+		idempotency.Fingerprint("POST /api/sendCoin", []byte(`{"amount":2}`)))
+}
+
+func TestValidation(t *testing.T) {
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if username := r.Header.Get("X-Test-User"); username != "" {
+				r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{Username: username, Role: auth.RoleEmployee}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	r.With(Validation(spec, true)).Post("/api/sendCoin", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	r.With(Validation(spec, true)).Get("/api/info", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"coins":"plenty"}`)
+	})
+
+	send := func(user, body string) (*httptest.ResponseRecorder, problem.Details) {
+		req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Test-User", user)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var details problem.Details
+		if w.Code != http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &details))
+		}
+
+		return w, details
+	}
+
+	w, _ := send("alice", `{"toUser":"bob","amount":5}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w, details := send("alice", `{"amount":5}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, problem.InvalidRequest.Code, details.Code)
+	assert.Equal(t, []problem.FieldError{{Field: "body.toUser", Message: `property "toUser" is missing`}}, details.Errors)
+
+	w, details = send("alice", `{"toUser":"bob","amount":"5"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Len(t, details.Errors, 1)
+	assert.Equal(t, "body.amount", details.Errors[0].Field)
+
+	w, details = send("", `{"toUser":"bob","amount":5}`)
+	assert.Equal(t, http.StatusUnauthorized, w.Code, "the security requirement of the route is checked")
+	assert.Equal(t, problem.Unauthorized.Code, details.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+	req.Header.Set("X-Test-User", "alice")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, "responses that break the spec are caught")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &details))
+	assert.Equal(t, "invalid_response", details.Code)
+	require.Len(t, details.Errors, 1)
+	assert.Equal(t, "response.coins", details.Errors[0].Field)
+}
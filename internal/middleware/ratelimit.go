@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/metrics"
+	"github.com/basedalex/merch-shop/internal/problem"
+	"github.com/basedalex/merch-shop/internal/ratelimit"
+)
+
+// Rate limit headers as in the IETF draft "RateLimit header fields for HTTP".
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+)
+
+// RateLimit throttles each client per route. Authenticated clients are told apart by the
+// username or service account Authentication put in the context, anonymous ones by IP.
+// Like Authorize it needs the matched route, so it is registered through api.ChiServerOptions.
+// When the store fails the request is let through.
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+
+			res, limited, err := limiter.Take(r.Context(), route, rateLimitClient(r))
+			if err != nil {
+				logger.WithContext(r.Context()).Error(fmt.Errorf("error checking rate limit: %w", err))
+			}
+
+			if !limited || err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set(RateLimitLimitHeader, strconv.Itoa(res.Limit))
+			w.Header().Set(RateLimitRemainingHeader, strconv.Itoa(res.Remaining))
+			w.Header().Set(RateLimitResetHeader, ceilSeconds(res.Reset))
+
+			if !res.Allowed {
+				metrics.RateLimited.WithLabelValues(route).Inc()
+				w.Header().Set("Retry-After", ceilSeconds(res.RetryAfter))
+				problem.Write(w, r, problem.New(r, http.StatusTooManyRequests, problem.TooManyRequests, ""))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rateLimitClient(r *http.Request) string {
+	if principal := principalKey(r); principal != "" {
+		return principal
+	}
+
+	return "ip:" + ClientIP(r)
+}
+
+// principalKey names the employee or service account Authentication put in the context,
+// empty for anonymous requests.
+func principalKey(r *http.Request) string {
+	if account, ok := auth.ServiceAccountFromContext(r.Context()); ok {
+		return "service-account:" + account.Name
+	}
+
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+		return "user:" + claims.Username
+	}
+
+	return ""
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"runtime/debug"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/basedalex/merch-shop/internal/problem"
+)
+
+// Recoverer turns a panic in a handler into a logged stack trace and a 500 response,
+// instead of a dropped connection. It runs inside AccessLog so the 500 is logged too.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w}
+
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+
+			// the server aborts the response on purpose with this one
+			if err, ok := p.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(p)
+			}
+
+			requestID := RequestIDFromContext(r.Context())
+
+			logger.WithContext(r.Context()).WithFields(log.Fields{
+				"request_id": requestID,
+				"panic":      p,
+				"stack":      string(debug.Stack()),
+			}).Error("panic while serving request")
+
+			// too late to change the status once the handler has started the response
+			if rec.status != 0 {
+				return
+			}
+
+			writeInternalError(w, r)
+		}()
+
+		next.ServeHTTP(rec, r)
+	})
+}
+
+// writeInternalError answers with the same problem the service answers server errors with,
+// plus the request ID to quote in a bug report.
+func writeInternalError(w http.ResponseWriter, r *http.Request) {
+	details := problem.New(r, http.StatusInternalServerError, problem.Internal, "")
+	details.RequestID = RequestIDFromContext(r.Context())
+	problem.Write(w, r, details)
+}
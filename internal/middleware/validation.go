@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/problem"
+)
+
+// Security schemes of the spec.
+const (
+	bearerScheme = "BearerAuth"
+	apiKeyScheme = "ApiKeyAuth"
+)
+
+var errNotAuthenticated = errors.New("request is not authenticated by the scheme")
+
+var invalidResponse = problem.Kind{
+	Code:    "invalid_response",
+	English: "Response does not match the API specification",
+	Russian: "Ответ не соответствует спецификации API",
+	Detail:  "The response was withheld because it breaks the specification.",
+}
+
+// Validation checks requests against the OpenAPI spec, including the security requirements
+// Authentication has met, and answers a violation with 400 listing the offending fields.
+// With responses it also checks what the handler answered and replaces a response that breaks
+// the spec with a 500; that buffers every response, so it is a debug mode. Like Authorize it
+// needs the matched route, so it is registered through api.ChiServerOptions, next to the handler.
+func Validation(spec *openapi3.T, responses bool) func(http.Handler) http.Handler {
+	options := &openapi3filter.Options{MultiError: true, AuthenticationFunc: authenticated}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, params, ok := specRoute(spec, r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			defaultContentType(r, route.Operation)
+
+			input := &openapi3filter.RequestValidationInput{Request: r, PathParams: params, Route: route, Options: options}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				writeInvalidRequest(w, r, err)
+				return
+			}
+
+			if !responses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			buf := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(buf, r)
+
+			err := openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 buf.status,
+				Header:                 buf.header,
+				Body:                   io.NopCloser(bytes.NewReader(buf.body.Bytes())),
+				Options:                options,
+			})
+			if err != nil {
+				logger.WithContext(r.Context()).Error(fmt.Errorf("response does not match the spec: %w", err))
+
+				details := problem.New(r, http.StatusInternalServerError, invalidResponse, "")
+				details.Errors = fieldErrors(err, "response")
+				problem.Write(w, r, details)
+				return
+			}
+
+			buf.flush(w)
+		})
+	}
+}
+
+// specRoute finds the operation of the route chi matched; the patterns of both are the same.
+func specRoute(spec *openapi3.T, r *http.Request) (*routers.Route, map[string]string, bool) {
+	rctx := chi.RouteContext(r.Context())
+	pattern := rctx.RoutePattern()
+
+	pathItem := spec.Paths.Value(pattern)
+	if pathItem == nil {
+		return nil, nil, false
+	}
+
+	operation := pathItem.GetOperation(r.Method)
+	if operation == nil {
+		return nil, nil, false
+	}
+
+	params := make(map[string]string, len(rctx.URLParams.Keys))
+	for i, key := range rctx.URLParams.Keys {
+		params[key] = rctx.URLParams.Values[i]
+	}
+
+	return &routers.Route{Spec: spec, Path: pattern, PathItem: pathItem, Method: r.Method, Operation: operation}, params, true
+}
+
+// defaultContentType lets clients that always worked without a Content-Type keep doing so,
+// when the operation takes a body of one media type only.
+func defaultContentType(r *http.Request, operation *openapi3.Operation) {
+	if r.Header.Get("Content-Type") != "" || operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return
+	}
+
+	if content := operation.RequestBody.Value.Content; len(content) == 1 {
+		for mediaType := range content {
+			r.Header.Set("Content-Type", mediaType)
+		}
+	}
+}
+
+// authenticated checks a security scheme against the principal Authentication put in the context.
+func authenticated(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+	ctx := input.RequestValidationInput.Request.Context()
+
+	switch input.SecuritySchemeName {
+	case bearerScheme:
+		if _, ok := auth.ClaimsFromContext(ctx); ok {
+			return nil
+		}
+	case apiKeyScheme:
+		if _, ok := auth.ServiceAccountFromContext(ctx); ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %s", errNotAuthenticated, input.SecuritySchemeName)
+}
+
+func writeInvalidRequest(w http.ResponseWriter, r *http.Request, err error) {
+	var security *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &security) {
+		status, kind := http.StatusUnauthorized, problem.Unauthorized
+		if principalKey(r) != "" {
+			status, kind = http.StatusForbidden, problem.Forbidden
+		}
+
+		problem.Write(w, r, problem.New(r, status, kind, "the route does not accept these credentials"))
+		return
+	}
+
+	details := problem.New(r, http.StatusBadRequest, problem.InvalidRequest, "")
+	details.Errors = fieldErrors(err, "")
+	problem.Write(w, r, details)
+}
+
+// fieldErrors flattens the errors of kin-openapi into the fields they are about.
+func fieldErrors(err error, field string) []problem.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var out []problem.FieldError
+		for _, err := range e {
+			out = append(out, fieldErrors(err, field)...)
+		}
+
+		return out
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.In + "." + e.Parameter.Name
+		case e.RequestBody != nil:
+			field = "body"
+		}
+
+		if e.Err == nil {
+			return []problem.FieldError{{Field: field, Message: e.Reason}}
+		}
+
+		return fieldErrors(e.Err, field)
+	case *openapi3filter.ResponseError:
+		if e.Err == nil {
+			return []problem.FieldError{{Field: field, Message: e.Reason}}
+		}
+
+		return fieldErrors(e.Err, field)
+	case *openapi3.SchemaError:
+		return []problem.FieldError{{Field: joinField(field, e.JSONPointer()), Message: e.Reason}}
+	}
+
+	return []problem.FieldError{{Field: field, Message: err.Error()}}
+}
+
+func joinField(field string, path []string) string {
+	parts := append([]string{field}, path...)
+	if field == "" {
+		parts = path
+	}
+
+	return strings.Join(parts, ".")
+}
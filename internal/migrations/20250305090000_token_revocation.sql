@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE revoked_tokens (
+    jti TEXT PRIMARY KEY,
+    username TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX revoked_tokens_revoked_at_idx ON revoked_tokens (revoked_at);
+
+CREATE TABLE session_revocations (
+    username TEXT PRIMARY KEY REFERENCES employees(username) ON DELETE CASCADE,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE session_revocations;
+DROP TABLE revoked_tokens;
+-- +goose StatementEnd
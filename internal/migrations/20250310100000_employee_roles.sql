@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE employees
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'employee'
+    CHECK (role IN ('employee', 'shop-manager', 'finance-admin'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE employees DROP COLUMN role;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE invites (
+    code_hash TEXT PRIMARY KEY,
+    created_by TEXT NOT NULL REFERENCES employees(username) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_by TEXT REFERENCES employees(username) ON DELETE SET NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE invites;
+-- +goose StatementEnd
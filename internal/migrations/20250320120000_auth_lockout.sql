@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth_failures (
+    key TEXT PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    blocked_until TIMESTAMPTZ
+);
+
+CREATE TABLE lockout_events (
+    id BIGSERIAL PRIMARY KEY,
+    key TEXT NOT NULL,
+    failures INT NOT NULL,
+    locked_until TIMESTAMPTZ NOT NULL,
+    unlocked_by TEXT,
+    unlocked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX lockout_events_key_idx ON lockout_events (key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE lockout_events;
+DROP TABLE auth_failures;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE password_resets (
+    token_hash TEXT PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES employees(username) ON DELETE CASCADE,
+    created_by TEXT NOT NULL REFERENCES employees(username) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_password_resets_username ON password_resets(username);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE password_resets;
+-- +goose StatementEnd
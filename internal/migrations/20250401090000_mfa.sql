@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE employee_mfa (
+    username TEXT PRIMARY KEY REFERENCES employees(username) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE mfa_recovery_codes (
+    code_hash TEXT PRIMARY KEY,
+    username TEXT NOT NULL REFERENCES employees(username) ON DELETE CASCADE,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_mfa_recovery_codes_username ON mfa_recovery_codes(username);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE mfa_recovery_codes;
+DROP TABLE employee_mfa;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE service_accounts (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE api_keys (
+    prefix TEXT PRIMARY KEY,
+    key_hash TEXT NOT NULL UNIQUE,
+    account TEXT NOT NULL REFERENCES service_accounts(name) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_api_keys_account ON api_keys(account);
+
+CREATE TABLE coin_grants (
+    id BIGSERIAL PRIMARY KEY,
+    receiver TEXT NOT NULL REFERENCES employees(username) ON DELETE CASCADE,
+    amount INT NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL DEFAULT '',
+    granted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE coin_grants;
+DROP TABLE api_keys;
+DROP TABLE service_accounts;
+-- +goose StatementEnd
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/basedalex/merch-shop/internal/db"
 	api "github.com/basedalex/merch-shop/internal/swagger"
@@ -51,6 +52,35 @@ func (mr *MockRepositoryMockRecorder) Authenticate(ctx, authRequest interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockRepository)(nil).Authenticate), ctx, authRequest)
 }
 
+// AuthenticateAPIKey mocks base method.
+func (m *MockRepository) AuthenticateAPIKey(ctx context.Context, prefix, hash string) (*db.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, prefix, hash)
+	ret0, _ := ret[0].(*db.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockRepositoryMockRecorder) AuthenticateAPIKey(ctx, prefix, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockRepository)(nil).AuthenticateAPIKey), ctx, prefix, hash)
+}
+
+// BlockAuthKey mocks base method.
+func (m *MockRepository) BlockAuthKey(ctx context.Context, key string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockAuthKey", ctx, key, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockAuthKey indicates an expected call of BlockAuthKey.
+func (mr *MockRepositoryMockRecorder) BlockAuthKey(ctx, key, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockAuthKey", reflect.TypeOf((*MockRepository)(nil).BlockAuthKey), ctx, key, until)
+}
+
 // BuyItem mocks base method.
 func (m *MockRepository) BuyItem(ctx context.Context, employeeName, item string) error {
 	m.ctrl.T.Helper()
@@ -65,6 +95,49 @@ func (mr *MockRepositoryMockRecorder) BuyItem(ctx, employeeName, item interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyItem", reflect.TypeOf((*MockRepository)(nil).BuyItem), ctx, employeeName, item)
 }
 
+// ChangePassword mocks base method.
+func (m *MockRepository) ChangePassword(ctx context.Context, username, passwordHash string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, username, passwordHash)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockRepositoryMockRecorder) ChangePassword(ctx, username, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockRepository)(nil).ChangePassword), ctx, username, passwordHash)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockRepository) ConfirmMFA(ctx context.Context, username string, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMFA", ctx, username, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockRepositoryMockRecorder) ConfirmMFA(ctx, username, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockRepository)(nil).ConfirmMFA), ctx, username, step)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockRepository) CreateAPIKey(ctx context.Context, key db.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockRepositoryMockRecorder) CreateAPIKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockRepository)(nil).CreateAPIKey), ctx, key)
+}
+
 // CreateEmployee mocks base method.
 func (m *MockRepository) CreateEmployee(ctx context.Context, authRequest api.AuthRequest) error {
 	m.ctrl.T.Helper()
@@ -79,6 +152,147 @@ func (mr *MockRepositoryMockRecorder) CreateEmployee(ctx, authRequest interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployee", reflect.TypeOf((*MockRepository)(nil).CreateEmployee), ctx, authRequest)
 }
 
+// CreateEmployeeWithInvite mocks base method.
+func (m *MockRepository) CreateEmployeeWithInvite(ctx context.Context, authRequest api.AuthRequest, inviteHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmployeeWithInvite", ctx, authRequest, inviteHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmployeeWithInvite indicates an expected call of CreateEmployeeWithInvite.
+func (mr *MockRepositoryMockRecorder) CreateEmployeeWithInvite(ctx, authRequest, inviteHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployeeWithInvite", reflect.TypeOf((*MockRepository)(nil).CreateEmployeeWithInvite), ctx, authRequest, inviteHash)
+}
+
+// CreateEmployeeWithRole mocks base method.
+func (m *MockRepository) CreateEmployeeWithRole(ctx context.Context, authRequest api.AuthRequest, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmployeeWithRole", ctx, authRequest, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmployeeWithRole indicates an expected call of CreateEmployeeWithRole.
+func (mr *MockRepositoryMockRecorder) CreateEmployeeWithRole(ctx, authRequest, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployeeWithRole", reflect.TypeOf((*MockRepository)(nil).CreateEmployeeWithRole), ctx, authRequest, role)
+}
+
+// CreateInvite mocks base method.
+func (m *MockRepository) CreateInvite(ctx context.Context, invite db.Invite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockRepositoryMockRecorder) CreateInvite(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockRepository)(nil).CreateInvite), ctx, invite)
+}
+
+// CreateOIDCLogin mocks base method.
+func (m *MockRepository) CreateOIDCLogin(ctx context.Context, login db.OIDCLogin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOIDCLogin", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOIDCLogin indicates an expected call of CreateOIDCLogin.
+func (mr *MockRepositoryMockRecorder) CreateOIDCLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCLogin", reflect.TypeOf((*MockRepository)(nil).CreateOIDCLogin), ctx, login)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockRepository) CreatePasswordReset(ctx context.Context, reset db.PasswordReset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, reset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockRepositoryMockRecorder) CreatePasswordReset(ctx, reset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockRepository)(nil).CreatePasswordReset), ctx, reset)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockRepository) CreateRefreshToken(ctx context.Context, token db.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockRepositoryMockRecorder) CreateRefreshToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockRepository)(nil).CreateRefreshToken), ctx, token)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockRepository) CreateServiceAccount(ctx context.Context, account db.ServiceAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockRepositoryMockRecorder) CreateServiceAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockRepository)(nil).CreateServiceAccount), ctx, account)
+}
+
+// DisableMFA mocks base method.
+func (m *MockRepository) DisableMFA(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockRepositoryMockRecorder) DisableMFA(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockRepository)(nil).DisableMFA), ctx, username)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockRepository) EnrollMFA(ctx context.Context, username, secret string, recoveryHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, username, secret, recoveryHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockRepositoryMockRecorder) EnrollMFA(ctx, username, secret, recoveryHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockRepository)(nil).EnrollMFA), ctx, username, secret, recoveryHashes)
+}
+
+// GetBlockedUntil mocks base method.
+func (m *MockRepository) GetBlockedUntil(ctx context.Context, keys []string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUntil", ctx, keys)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUntil indicates an expected call of GetBlockedUntil.
+func (mr *MockRepositoryMockRecorder) GetBlockedUntil(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUntil", reflect.TypeOf((*MockRepository)(nil).GetBlockedUntil), ctx, keys)
+}
+
 // GetEmployeeInfo mocks base method.
 func (m *MockRepository) GetEmployeeInfo(ctx context.Context, employeeName string) (*db.InfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +308,299 @@ func (mr *MockRepositoryMockRecorder) GetEmployeeInfo(ctx, employeeName interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeInfo", reflect.TypeOf((*MockRepository)(nil).GetEmployeeInfo), ctx, employeeName)
 }
 
+// GetEmployeeRole mocks base method.
+func (m *MockRepository) GetEmployeeRole(ctx context.Context, username string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeRole", ctx, username)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeRole indicates an expected call of GetEmployeeRole.
+func (mr *MockRepositoryMockRecorder) GetEmployeeRole(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeRole", reflect.TypeOf((*MockRepository)(nil).GetEmployeeRole), ctx, username)
+}
+
+// GetMFA mocks base method.
+func (m *MockRepository) GetMFA(ctx context.Context, username string) (*db.MFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMFA", ctx, username)
+	ret0, _ := ret[0].(*db.MFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFA indicates an expected call of GetMFA.
+func (mr *MockRepositoryMockRecorder) GetMFA(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFA", reflect.TypeOf((*MockRepository)(nil).GetMFA), ctx, username)
+}
+
+// GrantCoins mocks base method.
+func (m *MockRepository) GrantCoins(ctx context.Context, grant db.CoinGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantCoins", ctx, grant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantCoins indicates an expected call of GrantCoins.
+func (mr *MockRepositoryMockRecorder) GrantCoins(ctx, grant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCoins", reflect.TypeOf((*MockRepository)(nil).GrantCoins), ctx, grant)
+}
+
+// LinkIdentity mocks base method.
+func (m *MockRepository) LinkIdentity(ctx context.Context, identity db.ExternalIdentity) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkIdentity", ctx, identity)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkIdentity indicates an expected call of LinkIdentity.
+func (mr *MockRepositoryMockRecorder) LinkIdentity(ctx, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkIdentity", reflect.TypeOf((*MockRepository)(nil).LinkIdentity), ctx, identity)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockRepository) ListAPIKeys(ctx context.Context, account string) ([]db.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, account)
+	ret0, _ := ret[0].([]db.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockRepositoryMockRecorder) ListAPIKeys(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockRepository)(nil).ListAPIKeys), ctx, account)
+}
+
+// ListBalances mocks base method.
+func (m *MockRepository) ListBalances(ctx context.Context) ([]db.EmployeeBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalances", ctx)
+	ret0, _ := ret[0].([]db.EmployeeBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalances indicates an expected call of ListBalances.
+func (mr *MockRepositoryMockRecorder) ListBalances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalances", reflect.TypeOf((*MockRepository)(nil).ListBalances), ctx)
+}
+
+// ListLockouts mocks base method.
+func (m *MockRepository) ListLockouts(ctx context.Context) ([]db.LockoutEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLockouts", ctx)
+	ret0, _ := ret[0].([]db.LockoutEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLockouts indicates an expected call of ListLockouts.
+func (mr *MockRepositoryMockRecorder) ListLockouts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLockouts", reflect.TypeOf((*MockRepository)(nil).ListLockouts), ctx)
+}
+
+// ListRevocations mocks base method.
+func (m *MockRepository) ListRevocations(ctx context.Context, since time.Time) (*db.Revocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevocations", ctx, since)
+	ret0, _ := ret[0].(*db.Revocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevocations indicates an expected call of ListRevocations.
+func (mr *MockRepositoryMockRecorder) ListRevocations(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevocations", reflect.TypeOf((*MockRepository)(nil).ListRevocations), ctx, since)
+}
+
+// LockAuthKey mocks base method.
+func (m *MockRepository) LockAuthKey(ctx context.Context, event db.LockoutEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuthKey", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAuthKey indicates an expected call of LockAuthKey.
+func (mr *MockRepositoryMockRecorder) LockAuthKey(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuthKey", reflect.TypeOf((*MockRepository)(nil).LockAuthKey), ctx, event)
+}
+
+// PutItem mocks base method.
+func (m *MockRepository) PutItem(ctx context.Context, item string, price int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutItem", ctx, item, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutItem indicates an expected call of PutItem.
+func (mr *MockRepositoryMockRecorder) PutItem(ctx, item, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItem", reflect.TypeOf((*MockRepository)(nil).PutItem), ctx, item, price)
+}
+
+// RecordAuthFailure mocks base method.
+func (m *MockRepository) RecordAuthFailure(ctx context.Context, key string, resetAfter time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuthFailure", ctx, key, resetAfter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordAuthFailure indicates an expected call of RecordAuthFailure.
+func (mr *MockRepositoryMockRecorder) RecordAuthFailure(ctx, key, resetAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuthFailure", reflect.TypeOf((*MockRepository)(nil).RecordAuthFailure), ctx, key, resetAfter)
+}
+
+// ResetAuthFailures mocks base method.
+func (m *MockRepository) ResetAuthFailures(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetAuthFailures", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetAuthFailures indicates an expected call of ResetAuthFailures.
+func (mr *MockRepositoryMockRecorder) ResetAuthFailures(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetAuthFailures", reflect.TypeOf((*MockRepository)(nil).ResetAuthFailures), ctx, key)
+}
+
+// ResetPassword mocks base method.
+func (m *MockRepository) ResetPassword(ctx context.Context, resetHash, passwordHash string) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, resetHash, passwordHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockRepositoryMockRecorder) ResetPassword(ctx, resetHash, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockRepository)(nil).ResetPassword), ctx, resetHash, passwordHash)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockRepository) RevokeAPIKey(ctx context.Context, account, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, account, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockRepositoryMockRecorder) RevokeAPIKey(ctx, account, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockRepository)(nil).RevokeAPIKey), ctx, account, prefix)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockRepository) RevokeAllSessions(ctx context.Context, username string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", ctx, username)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockRepositoryMockRecorder) RevokeAllSessions(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockRepository)(nil).RevokeAllSessions), ctx, username)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockRepository) RevokeRefreshTokenFamily(ctx context.Context, hash, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, hash, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockRepositoryMockRecorder) RevokeRefreshTokenFamily(ctx, hash, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockRepository)(nil).RevokeRefreshTokenFamily), ctx, hash, username)
+}
+
+// RevokeToken mocks base method.
+func (m *MockRepository) RevokeToken(ctx context.Context, token db.RevokedToken, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, token, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockRepositoryMockRecorder) RevokeToken(ctx, token, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockRepository)(nil).RevokeToken), ctx, token, username)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockRepository) RotateRefreshToken(ctx context.Context, oldHash string, next db.RefreshToken) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, oldHash, next)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockRepositoryMockRecorder) RotateRefreshToken(ctx, oldHash, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockRepository)(nil).RotateRefreshToken), ctx, oldHash, next)
+}
+
+// SetEmployeeRole mocks base method.
+func (m *MockRepository) SetEmployeeRole(ctx context.Context, username, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmployeeRole", ctx, username, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmployeeRole indicates an expected call of SetEmployeeRole.
+func (mr *MockRepositoryMockRecorder) SetEmployeeRole(ctx, username, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmployeeRole", reflect.TypeOf((*MockRepository)(nil).SetEmployeeRole), ctx, username, role)
+}
+
+// TakeOIDCLogin mocks base method.
+func (m *MockRepository) TakeOIDCLogin(ctx context.Context, stateHash string) (*db.OIDCLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeOIDCLogin", ctx, stateHash)
+	ret0, _ := ret[0].(*db.OIDCLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeOIDCLogin indicates an expected call of TakeOIDCLogin.
+func (mr *MockRepositoryMockRecorder) TakeOIDCLogin(ctx, stateHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeOIDCLogin", reflect.TypeOf((*MockRepository)(nil).TakeOIDCLogin), ctx, stateHash)
+}
+
 // TransferCoins mocks base method.
 func (m *MockRepository) TransferCoins(ctx context.Context, senderName, receiverName string, amount int) error {
 	m.ctrl.T.Helper()
@@ -107,3 +614,45 @@ func (mr *MockRepositoryMockRecorder) TransferCoins(ctx, senderName, receiverNam
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferCoins", reflect.TypeOf((*MockRepository)(nil).TransferCoins), ctx, senderName, receiverName, amount)
 }
+
+// UnlockAuthKey mocks base method.
+func (m *MockRepository) UnlockAuthKey(ctx context.Context, key, unlockedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAuthKey", ctx, key, unlockedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAuthKey indicates an expected call of UnlockAuthKey.
+func (mr *MockRepositoryMockRecorder) UnlockAuthKey(ctx, key, unlockedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAuthKey", reflect.TypeOf((*MockRepository)(nil).UnlockAuthKey), ctx, key, unlockedBy)
+}
+
+// UseMFAStep mocks base method.
+func (m *MockRepository) UseMFAStep(ctx context.Context, username string, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFAStep", ctx, username, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseMFAStep indicates an expected call of UseMFAStep.
+func (mr *MockRepositoryMockRecorder) UseMFAStep(ctx, username, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFAStep", reflect.TypeOf((*MockRepository)(nil).UseMFAStep), ctx, username, step)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockRepository) UseRecoveryCode(ctx context.Context, username, codeHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, username, codeHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockRepositoryMockRecorder) UseRecoveryCode(ctx, username, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockRepository)(nil).UseRecoveryCode), ctx, username, codeHash)
+}
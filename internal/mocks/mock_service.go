@@ -5,9 +5,11 @@
 package mocks
 
 import (
-	http "net/http"
+	context "context"
 	reflect "reflect"
+	time "time"
 
+	api "github.com/basedalex/merch-shop/internal/swagger"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,50 +36,499 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// DeleteApiAdminEmployeesUsernameLockout mocks base method.
+func (m *MockService) DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameLockoutRequestObject) (api.DeleteApiAdminEmployeesUsernameLockoutResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiAdminEmployeesUsernameLockout", ctx, request)
+	ret0, _ := ret[0].(api.DeleteApiAdminEmployeesUsernameLockoutResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteApiAdminEmployeesUsernameLockout indicates an expected call of DeleteApiAdminEmployeesUsernameLockout.
+func (mr *MockServiceMockRecorder) DeleteApiAdminEmployeesUsernameLockout(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiAdminEmployeesUsernameLockout", reflect.TypeOf((*MockService)(nil).DeleteApiAdminEmployeesUsernameLockout), ctx, request)
+}
+
+// DeleteApiAdminEmployeesUsernameSessions mocks base method.
+func (m *MockService) DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameSessionsRequestObject) (api.DeleteApiAdminEmployeesUsernameSessionsResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiAdminEmployeesUsernameSessions", ctx, request)
+	ret0, _ := ret[0].(api.DeleteApiAdminEmployeesUsernameSessionsResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteApiAdminEmployeesUsernameSessions indicates an expected call of DeleteApiAdminEmployeesUsernameSessions.
+func (mr *MockServiceMockRecorder) DeleteApiAdminEmployeesUsernameSessions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiAdminEmployeesUsernameSessions", reflect.TypeOf((*MockService)(nil).DeleteApiAdminEmployeesUsernameSessions), ctx, request)
+}
+
+// DeleteApiAdminServiceAccountsNameKeysPrefix mocks base method.
+func (m *MockService) DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, request api.DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject) (api.DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiAdminServiceAccountsNameKeysPrefix", ctx, request)
+	ret0, _ := ret[0].(api.DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteApiAdminServiceAccountsNameKeysPrefix indicates an expected call of DeleteApiAdminServiceAccountsNameKeysPrefix.
+func (mr *MockServiceMockRecorder) DeleteApiAdminServiceAccountsNameKeysPrefix(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiAdminServiceAccountsNameKeysPrefix", reflect.TypeOf((*MockService)(nil).DeleteApiAdminServiceAccountsNameKeysPrefix), ctx, request)
+}
+
+// GetApiAdminLockouts mocks base method.
+func (m *MockService) GetApiAdminLockouts(ctx context.Context, request api.GetApiAdminLockoutsRequestObject) (api.GetApiAdminLockoutsResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiAdminLockouts", ctx, request)
+	ret0, _ := ret[0].(api.GetApiAdminLockoutsResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiAdminLockouts indicates an expected call of GetApiAdminLockouts.
+func (mr *MockServiceMockRecorder) GetApiAdminLockouts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiAdminLockouts", reflect.TypeOf((*MockService)(nil).GetApiAdminLockouts), ctx, request)
+}
+
+// GetApiAdminServiceAccountsNameKeys mocks base method.
+func (m *MockService) GetApiAdminServiceAccountsNameKeys(ctx context.Context, request api.GetApiAdminServiceAccountsNameKeysRequestObject) (api.GetApiAdminServiceAccountsNameKeysResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiAdminServiceAccountsNameKeys", ctx, request)
+	ret0, _ := ret[0].(api.GetApiAdminServiceAccountsNameKeysResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiAdminServiceAccountsNameKeys indicates an expected call of GetApiAdminServiceAccountsNameKeys.
+func (mr *MockServiceMockRecorder) GetApiAdminServiceAccountsNameKeys(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiAdminServiceAccountsNameKeys", reflect.TypeOf((*MockService)(nil).GetApiAdminServiceAccountsNameKeys), ctx, request)
+}
+
+// GetApiAuthOidcCallback mocks base method.
+func (m *MockService) GetApiAuthOidcCallback(ctx context.Context, request api.GetApiAuthOidcCallbackRequestObject) (api.GetApiAuthOidcCallbackResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiAuthOidcCallback", ctx, request)
+	ret0, _ := ret[0].(api.GetApiAuthOidcCallbackResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiAuthOidcCallback indicates an expected call of GetApiAuthOidcCallback.
+func (mr *MockServiceMockRecorder) GetApiAuthOidcCallback(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiAuthOidcCallback", reflect.TypeOf((*MockService)(nil).GetApiAuthOidcCallback), ctx, request)
+}
+
+// GetApiAuthOidcLogin mocks base method.
+func (m *MockService) GetApiAuthOidcLogin(ctx context.Context, request api.GetApiAuthOidcLoginRequestObject) (api.GetApiAuthOidcLoginResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiAuthOidcLogin", ctx, request)
+	ret0, _ := ret[0].(api.GetApiAuthOidcLoginResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiAuthOidcLogin indicates an expected call of GetApiAuthOidcLogin.
+func (mr *MockServiceMockRecorder) GetApiAuthOidcLogin(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiAuthOidcLogin", reflect.TypeOf((*MockService)(nil).GetApiAuthOidcLogin), ctx, request)
+}
+
 // GetApiBuyItem mocks base method.
-func (m *MockService) GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string) {
+func (m *MockService) GetApiBuyItem(ctx context.Context, request api.GetApiBuyItemRequestObject) (api.GetApiBuyItemResponseObject, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "GetApiBuyItem", w, r, item)
+	ret := m.ctrl.Call(m, "GetApiBuyItem", ctx, request)
+	ret0, _ := ret[0].(api.GetApiBuyItemResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetApiBuyItem indicates an expected call of GetApiBuyItem.
-func (mr *MockServiceMockRecorder) GetApiBuyItem(w, r, item interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetApiBuyItem(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiBuyItem", reflect.TypeOf((*MockService)(nil).GetApiBuyItem), w, r, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiBuyItem", reflect.TypeOf((*MockService)(nil).GetApiBuyItem), ctx, request)
 }
 
 // GetApiInfo mocks base method.
-func (m *MockService) GetApiInfo(w http.ResponseWriter, r *http.Request) {
+func (m *MockService) GetApiInfo(ctx context.Context, request api.GetApiInfoRequestObject) (api.GetApiInfoResponseObject, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "GetApiInfo", w, r)
+	ret := m.ctrl.Call(m, "GetApiInfo", ctx, request)
+	ret0, _ := ret[0].(api.GetApiInfoResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetApiInfo indicates an expected call of GetApiInfo.
-func (mr *MockServiceMockRecorder) GetApiInfo(w, r interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetApiInfo(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiInfo", reflect.TypeOf((*MockService)(nil).GetApiInfo), ctx, request)
+}
+
+// GetApiReportsBalances mocks base method.
+func (m *MockService) GetApiReportsBalances(ctx context.Context, request api.GetApiReportsBalancesRequestObject) (api.GetApiReportsBalancesResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiReportsBalances", ctx, request)
+	ret0, _ := ret[0].(api.GetApiReportsBalancesResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiReportsBalances indicates an expected call of GetApiReportsBalances.
+func (mr *MockServiceMockRecorder) GetApiReportsBalances(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiReportsBalances", reflect.TypeOf((*MockService)(nil).GetApiReportsBalances), ctx, request)
+}
+
+// GetWellKnownJwksJson mocks base method.
+func (m *MockService) GetWellKnownJwksJson(ctx context.Context, request api.GetWellKnownJwksJsonRequestObject) (api.GetWellKnownJwksJsonResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWellKnownJwksJson", ctx, request)
+	ret0, _ := ret[0].(api.GetWellKnownJwksJsonResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWellKnownJwksJson indicates an expected call of GetWellKnownJwksJson.
+func (mr *MockServiceMockRecorder) GetWellKnownJwksJson(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiInfo", reflect.TypeOf((*MockService)(nil).GetApiInfo), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWellKnownJwksJson", reflect.TypeOf((*MockService)(nil).GetWellKnownJwksJson), ctx, request)
+}
+
+// PostApiAdminEmployees mocks base method.
+func (m *MockService) PostApiAdminEmployees(ctx context.Context, request api.PostApiAdminEmployeesRequestObject) (api.PostApiAdminEmployeesResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAdminEmployees", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAdminEmployeesResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAdminEmployees indicates an expected call of PostApiAdminEmployees.
+func (mr *MockServiceMockRecorder) PostApiAdminEmployees(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAdminEmployees", reflect.TypeOf((*MockService)(nil).PostApiAdminEmployees), ctx, request)
+}
+
+// PostApiAdminEmployeesUsernamePasswordReset mocks base method.
+func (m *MockService) PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, request api.PostApiAdminEmployeesUsernamePasswordResetRequestObject) (api.PostApiAdminEmployeesUsernamePasswordResetResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAdminEmployeesUsernamePasswordReset", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAdminEmployeesUsernamePasswordResetResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAdminEmployeesUsernamePasswordReset indicates an expected call of PostApiAdminEmployeesUsernamePasswordReset.
+func (mr *MockServiceMockRecorder) PostApiAdminEmployeesUsernamePasswordReset(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAdminEmployeesUsernamePasswordReset", reflect.TypeOf((*MockService)(nil).PostApiAdminEmployeesUsernamePasswordReset), ctx, request)
+}
+
+// PostApiAdminInvites mocks base method.
+func (m *MockService) PostApiAdminInvites(ctx context.Context, request api.PostApiAdminInvitesRequestObject) (api.PostApiAdminInvitesResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAdminInvites", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAdminInvitesResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAdminInvites indicates an expected call of PostApiAdminInvites.
+func (mr *MockServiceMockRecorder) PostApiAdminInvites(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAdminInvites", reflect.TypeOf((*MockService)(nil).PostApiAdminInvites), ctx, request)
+}
+
+// PostApiAdminServiceAccounts mocks base method.
+func (m *MockService) PostApiAdminServiceAccounts(ctx context.Context, request api.PostApiAdminServiceAccountsRequestObject) (api.PostApiAdminServiceAccountsResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAdminServiceAccounts", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAdminServiceAccountsResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAdminServiceAccounts indicates an expected call of PostApiAdminServiceAccounts.
+func (mr *MockServiceMockRecorder) PostApiAdminServiceAccounts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAdminServiceAccounts", reflect.TypeOf((*MockService)(nil).PostApiAdminServiceAccounts), ctx, request)
+}
+
+// PostApiAdminServiceAccountsNameKeys mocks base method.
+func (m *MockService) PostApiAdminServiceAccountsNameKeys(ctx context.Context, request api.PostApiAdminServiceAccountsNameKeysRequestObject) (api.PostApiAdminServiceAccountsNameKeysResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAdminServiceAccountsNameKeys", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAdminServiceAccountsNameKeysResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAdminServiceAccountsNameKeys indicates an expected call of PostApiAdminServiceAccountsNameKeys.
+func (mr *MockServiceMockRecorder) PostApiAdminServiceAccountsNameKeys(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAdminServiceAccountsNameKeys", reflect.TypeOf((*MockService)(nil).PostApiAdminServiceAccountsNameKeys), ctx, request)
 }
 
 // PostApiAuth mocks base method.
-func (m *MockService) PostApiAuth(w http.ResponseWriter, r *http.Request) {
+func (m *MockService) PostApiAuth(ctx context.Context, request api.PostApiAuthRequestObject) (api.PostApiAuthResponseObject, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PostApiAuth", w, r)
+	ret := m.ctrl.Call(m, "PostApiAuth", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAuthResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // PostApiAuth indicates an expected call of PostApiAuth.
-func (mr *MockServiceMockRecorder) PostApiAuth(w, r interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) PostApiAuth(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAuth", reflect.TypeOf((*MockService)(nil).PostApiAuth), ctx, request)
+}
+
+// PostApiAuthLogout mocks base method.
+func (m *MockService) PostApiAuthLogout(ctx context.Context, request api.PostApiAuthLogoutRequestObject) (api.PostApiAuthLogoutResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAuthLogout", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAuthLogoutResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAuthLogout indicates an expected call of PostApiAuthLogout.
+func (mr *MockServiceMockRecorder) PostApiAuthLogout(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAuth", reflect.TypeOf((*MockService)(nil).PostApiAuth), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAuthLogout", reflect.TypeOf((*MockService)(nil).PostApiAuthLogout), ctx, request)
+}
+
+// PostApiAuthMfa mocks base method.
+func (m *MockService) PostApiAuthMfa(ctx context.Context, request api.PostApiAuthMfaRequestObject) (api.PostApiAuthMfaResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAuthMfa", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAuthMfaResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAuthMfa indicates an expected call of PostApiAuthMfa.
+func (mr *MockServiceMockRecorder) PostApiAuthMfa(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAuthMfa", reflect.TypeOf((*MockService)(nil).PostApiAuthMfa), ctx, request)
+}
+
+// PostApiAuthRefresh mocks base method.
+func (m *MockService) PostApiAuthRefresh(ctx context.Context, request api.PostApiAuthRefreshRequestObject) (api.PostApiAuthRefreshResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiAuthRefresh", ctx, request)
+	ret0, _ := ret[0].(api.PostApiAuthRefreshResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiAuthRefresh indicates an expected call of PostApiAuthRefresh.
+func (mr *MockServiceMockRecorder) PostApiAuthRefresh(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiAuthRefresh", reflect.TypeOf((*MockService)(nil).PostApiAuthRefresh), ctx, request)
+}
+
+// PostApiCoinsGrant mocks base method.
+func (m *MockService) PostApiCoinsGrant(ctx context.Context, request api.PostApiCoinsGrantRequestObject) (api.PostApiCoinsGrantResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiCoinsGrant", ctx, request)
+	ret0, _ := ret[0].(api.PostApiCoinsGrantResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiCoinsGrant indicates an expected call of PostApiCoinsGrant.
+func (mr *MockServiceMockRecorder) PostApiCoinsGrant(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiCoinsGrant", reflect.TypeOf((*MockService)(nil).PostApiCoinsGrant), ctx, request)
+}
+
+// PostApiMfaConfirm mocks base method.
+func (m *MockService) PostApiMfaConfirm(ctx context.Context, request api.PostApiMfaConfirmRequestObject) (api.PostApiMfaConfirmResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiMfaConfirm", ctx, request)
+	ret0, _ := ret[0].(api.PostApiMfaConfirmResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiMfaConfirm indicates an expected call of PostApiMfaConfirm.
+func (mr *MockServiceMockRecorder) PostApiMfaConfirm(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiMfaConfirm", reflect.TypeOf((*MockService)(nil).PostApiMfaConfirm), ctx, request)
+}
+
+// PostApiMfaDisable mocks base method.
+func (m *MockService) PostApiMfaDisable(ctx context.Context, request api.PostApiMfaDisableRequestObject) (api.PostApiMfaDisableResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiMfaDisable", ctx, request)
+	ret0, _ := ret[0].(api.PostApiMfaDisableResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiMfaDisable indicates an expected call of PostApiMfaDisable.
+func (mr *MockServiceMockRecorder) PostApiMfaDisable(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiMfaDisable", reflect.TypeOf((*MockService)(nil).PostApiMfaDisable), ctx, request)
+}
+
+// PostApiMfaEnroll mocks base method.
+func (m *MockService) PostApiMfaEnroll(ctx context.Context, request api.PostApiMfaEnrollRequestObject) (api.PostApiMfaEnrollResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiMfaEnroll", ctx, request)
+	ret0, _ := ret[0].(api.PostApiMfaEnrollResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiMfaEnroll indicates an expected call of PostApiMfaEnroll.
+func (mr *MockServiceMockRecorder) PostApiMfaEnroll(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiMfaEnroll", reflect.TypeOf((*MockService)(nil).PostApiMfaEnroll), ctx, request)
+}
+
+// PostApiPassword mocks base method.
+func (m *MockService) PostApiPassword(ctx context.Context, request api.PostApiPasswordRequestObject) (api.PostApiPasswordResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiPassword", ctx, request)
+	ret0, _ := ret[0].(api.PostApiPasswordResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiPassword indicates an expected call of PostApiPassword.
+func (mr *MockServiceMockRecorder) PostApiPassword(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiPassword", reflect.TypeOf((*MockService)(nil).PostApiPassword), ctx, request)
+}
+
+// PostApiPasswordReset mocks base method.
+func (m *MockService) PostApiPasswordReset(ctx context.Context, request api.PostApiPasswordResetRequestObject) (api.PostApiPasswordResetResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiPasswordReset", ctx, request)
+	ret0, _ := ret[0].(api.PostApiPasswordResetResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiPasswordReset indicates an expected call of PostApiPasswordReset.
+func (mr *MockServiceMockRecorder) PostApiPasswordReset(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiPasswordReset", reflect.TypeOf((*MockService)(nil).PostApiPasswordReset), ctx, request)
+}
+
+// PostApiRegister mocks base method.
+func (m *MockService) PostApiRegister(ctx context.Context, request api.PostApiRegisterRequestObject) (api.PostApiRegisterResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostApiRegister", ctx, request)
+	ret0, _ := ret[0].(api.PostApiRegisterResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostApiRegister indicates an expected call of PostApiRegister.
+func (mr *MockServiceMockRecorder) PostApiRegister(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiRegister", reflect.TypeOf((*MockService)(nil).PostApiRegister), ctx, request)
 }
 
 // PostApiSendCoin mocks base method.
-func (m *MockService) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
+func (m *MockService) PostApiSendCoin(ctx context.Context, request api.PostApiSendCoinRequestObject) (api.PostApiSendCoinResponseObject, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PostApiSendCoin", w, r)
+	ret := m.ctrl.Call(m, "PostApiSendCoin", ctx, request)
+	ret0, _ := ret[0].(api.PostApiSendCoinResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // PostApiSendCoin indicates an expected call of PostApiSendCoin.
-func (mr *MockServiceMockRecorder) PostApiSendCoin(w, r interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) PostApiSendCoin(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiSendCoin", reflect.TypeOf((*MockService)(nil).PostApiSendCoin), ctx, request)
+}
+
+// PutApiAdminEmployeesUsernameRole mocks base method.
+func (m *MockService) PutApiAdminEmployeesUsernameRole(ctx context.Context, request api.PutApiAdminEmployeesUsernameRoleRequestObject) (api.PutApiAdminEmployeesUsernameRoleResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApiAdminEmployeesUsernameRole", ctx, request)
+	ret0, _ := ret[0].(api.PutApiAdminEmployeesUsernameRoleResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutApiAdminEmployeesUsernameRole indicates an expected call of PutApiAdminEmployeesUsernameRole.
+func (mr *MockServiceMockRecorder) PutApiAdminEmployeesUsernameRole(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApiAdminEmployeesUsernameRole", reflect.TypeOf((*MockService)(nil).PutApiAdminEmployeesUsernameRole), ctx, request)
+}
+
+// PutApiCatalogItem mocks base method.
+func (m *MockService) PutApiCatalogItem(ctx context.Context, request api.PutApiCatalogItemRequestObject) (api.PutApiCatalogItemResponseObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApiCatalogItem", ctx, request)
+	ret0, _ := ret[0].(api.PutApiCatalogItemResponseObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutApiCatalogItem indicates an expected call of PutApiCatalogItem.
+func (mr *MockServiceMockRecorder) PutApiCatalogItem(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApiCatalogItem", reflect.TypeOf((*MockService)(nil).PutApiCatalogItem), ctx, request)
+}
+
+// MockRevoker is a mock of Revoker interface.
+type MockRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokerMockRecorder
+}
+
+// MockRevokerMockRecorder is the mock recorder for MockRevoker.
+type MockRevokerMockRecorder struct {
+	mock *MockRevoker
+}
+
+// NewMockRevoker creates a new mock instance.
+func NewMockRevoker(ctrl *gomock.Controller) *MockRevoker {
+	mock := &MockRevoker{ctrl: ctrl}
+	mock.recorder = &MockRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevoker) EXPECT() *MockRevokerMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockRevoker) Revoke(jti string, expiresAt time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Revoke", jti, expiresAt)
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRevokerMockRecorder) Revoke(jti, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRevoker)(nil).Revoke), jti, expiresAt)
+}
+
+// RevokeUser mocks base method.
+func (m *MockRevoker) RevokeUser(username string, before time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RevokeUser", username, before)
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockRevokerMockRecorder) RevokeUser(username, before interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostApiSendCoin", reflect.TypeOf((*MockService)(nil).PostApiSendCoin), w, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockRevoker)(nil).RevokeUser), username, before)
 }
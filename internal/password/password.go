@@ -0,0 +1,134 @@
+package password
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+// maxLength is the bcrypt input limit, longer passwords would be silently truncated.
+const maxLength = 72
+
+var (
+	ErrTooShort = errors.New("password is too short")
+	ErrTooLong  = errors.New("password is too long")
+	ErrCommon   = errors.New("password is too common")
+)
+
+//go:embed common_passwords.txt
+var bundledCommonPasswords []byte
+
+// Policy decides which passwords may be set and how they are hashed.
+type Policy struct {
+	minLength int
+	cost      int
+	common    map[string]struct{}
+	// dummyHash is compared against for usernames that do not exist.
+	dummyHash []byte
+}
+
+func NewPolicy(cfg config.PasswordPolicy) (*Policy, error) {
+	list := io.Reader(bytes.NewReader(bundledCommonPasswords))
+
+	if cfg.CommonPasswordsFile != "" {
+		f, err := os.Open(cfg.CommonPasswordsFile)
+		if err != nil {
+			return nil, fmt.Errorf("error opening common passwords file: %w", err)
+		}
+		defer f.Close()
+
+		list = f
+	}
+
+	common, err := readList(list)
+	if err != nil {
+		return nil, fmt.Errorf("error reading common passwords: %w", err)
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("not a password of anyone"), Cost(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("error hashing dummy password: %w", err)
+	}
+
+	return &Policy{minLength: cfg.MinLength, cost: Cost(cfg), common: common, dummyHash: dummyHash}, nil
+}
+
+// Validate returns ErrTooShort, ErrTooLong or ErrCommon when the password may not be used.
+func (p *Policy) Validate(password string) error {
+	if len([]rune(password)) < p.minLength {
+		return fmt.Errorf("%w: at least %d characters are required", ErrTooShort, p.minLength)
+	}
+
+	if len(password) > maxLength {
+		return fmt.Errorf("%w: at most %d bytes are allowed", ErrTooLong, maxLength)
+	}
+
+	if _, ok := p.common[strings.ToLower(password)]; ok {
+		return ErrCommon
+	}
+
+	return nil
+}
+
+// CompareDummy takes as long as checking password against the hash of an employee, so a login
+// with a username that does not exist cannot be told apart by its response time.
+func (p *Policy) CompareDummy(password string) {
+	_ = bcrypt.CompareHashAndPassword(p.dummyHash, []byte(password))
+}
+
+func (p *Policy) Hash(password string) (string, error) {
+	return Hash(password, p.cost)
+}
+
+// Cost returns the configured bcrypt cost, bcrypt.DefaultCost when it is not set.
+func Cost(cfg config.PasswordPolicy) int {
+	if cfg.BcryptCost < bcrypt.MinCost {
+		return bcrypt.DefaultCost
+	}
+
+	return min(cfg.BcryptCost, bcrypt.MaxCost)
+}
+
+func Hash(password string, cost int) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", fmt.Errorf("error hashing password: %w", err)
+	}
+
+	return string(hashedPassword), nil
+}
+
+// NeedsRehash reports whether the hash was made with a lower cost than the current one.
+func NeedsRehash(hash string, cost int) bool {
+	hashCost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return false
+	}
+
+	return hashCost < cost
+}
+
+func readList(r io.Reader) (map[string]struct{}, error) {
+	list := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		list[strings.ToLower(line)] = struct{}{}
+	}
+
+	return list, scanner.Err()
+}
@@ -0,0 +1,67 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+func TestValidate(t *testing.T) {
+	policy, err := NewPolicy(config.PasswordPolicy{MinLength: 8})
+	require.NoError(t, err)
+
+	tests := []struct {
+		password string
+		err      error
+	}{
+		{password: "short", err: ErrTooShort},
+		{password: "Password123", err: ErrCommon},
+		{password: "correct-horse-battery"},
+		{password: string(make([]byte, maxLength+1)), err: ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		err := policy.Validate(tt.password)
+		if tt.err == nil {
+			assert.NoError(t, err, tt.password)
+		} else {
+			assert.True(t, errors.Is(err, tt.err), "%q: %v", tt.password, err)
+		}
+	}
+}
+
+func TestCommonPasswordsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "common.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# company specific\nmerch2025\n"), 0o600))
+
+	policy, err := NewPolicy(config.PasswordPolicy{CommonPasswordsFile: path})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, policy.Validate("Merch2025"), ErrCommon)
+	assert.NoError(t, policy.Validate("password"), "the file replaces the bundled list")
+}
+
+func TestNeedsRehash(t *testing.T) {
+	hash, err := Hash("correct-horse-battery", bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.True(t, NeedsRehash(hash, bcrypt.MinCost+1))
+	assert.False(t, NeedsRehash(hash, bcrypt.MinCost))
+	assert.Equal(t, bcrypt.DefaultCost, Cost(config.PasswordPolicy{}))
+}
+
+func TestDummyHashCost(t *testing.T) {
+	policy, err := NewPolicy(config.PasswordPolicy{BcryptCost: bcrypt.MinCost + 1})
+	require.NoError(t, err)
+
+	cost, err := bcrypt.Cost(policy.dummyHash)
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost+1, cost, "unknown usernames cost as much time as employees")
+}
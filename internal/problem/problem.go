@@ -0,0 +1,139 @@
+// Package problem writes errors as RFC 7807 problem details: a stable code clients can
+// branch on and a title in the language of the client instead of a free-text message.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"golang.org/x/text/language"
+
+	"github.com/basedalex/merch-shop/internal/logging"
+)
+
+var logger = logging.Logger("problem")
+
+const (
+	// ContentType is the media type of problem details.
+	ContentType = "application/problem+json"
+	// TypePrefix starts the type URI of every problem; the code completes it.
+	TypePrefix = "urn:merch-shop:problem:"
+)
+
+// Kind is a class of problems with a stable code, a title in every supported language and
+// a fixed detail. The detail is the same for every problem of the kind, so the cause of an
+// error, which may come from a library, is only logged.
+type Kind struct {
+	Code    string
+	English string
+	Russian string
+	Detail  string
+}
+
+// Kinds of every status the service answers with, for errors without a more specific one.
+var (
+	BadRequest          = Kind{"bad_request", "Bad request", "Неверный запрос", "The request misses a required value or has an invalid one."}
+	Unauthorized        = Kind{"unauthorized", "Authentication required", "Требуется аутентификация", "Send a valid access token or API key."}
+	Forbidden           = Kind{"forbidden", "Access denied", "Доступ запрещён", "The credentials do not allow this operation."}
+	NotFound            = Kind{"not_found", "Not found", "Не найдено", "The requested resource does not exist."}
+	Conflict            = Kind{"conflict", "Conflict with the current state", "Конфликт с текущим состоянием", "The request conflicts with the current state of the resource."}
+	UnprocessableEntity = Kind{"unprocessable_entity", "Request cannot be processed", "Запрос не может быть обработан", "The request is well-formed but cannot be carried out."}
+	TooManyRequests     = Kind{"too_many_requests", "Too many requests", "Слишком много запросов", "Retry after the time given in Retry-After."}
+	Internal            = Kind{"internal_error", "Internal server error", "Внутренняя ошибка сервера", "The error has been logged; quote the request ID when reporting it."}
+	// InvalidRequest is a request that does not match the OpenAPI spec, see Details.Errors.
+	InvalidRequest = Kind{"invalid_request", "Request does not match the API specification", "Запрос не соответствует спецификации API", "See errors for the fields that break the specification."}
+)
+
+var byStatus = map[int]Kind{
+	http.StatusBadRequest:          BadRequest,
+	http.StatusUnauthorized:        Unauthorized,
+	http.StatusForbidden:           Forbidden,
+	http.StatusNotFound:            NotFound,
+	http.StatusConflict:            Conflict,
+	http.StatusUnprocessableEntity: UnprocessableEntity,
+	http.StatusTooManyRequests:     TooManyRequests,
+	http.StatusInternalServerError: Internal,
+}
+
+// ForStatus returns the generic kind of a status.
+func ForStatus(status int) Kind {
+	if kind, ok := byStatus[status]; ok {
+		return kind
+	}
+
+	if status >= http.StatusInternalServerError {
+		return Internal
+	}
+
+	return BadRequest
+}
+
+// Details is the body of a problem response.
+type Details struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	// RequestID is set on server errors so it can be quoted in a bug report.
+	RequestID string `json:"requestId,omitempty"`
+	// Errors lists the fields that broke the spec.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is a violation of the spec. Field is a path such as "body.toUser" or "path.item".
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// the first one is the fallback for clients without Accept-Language
+var matcher = language.NewMatcher([]language.Tag{language.English, language.Russian})
+
+// Language picks the language of the titles from the Accept-Language of the request.
+func Language(r *http.Request) language.Tag {
+	tag, _ := language.MatchStrings(matcher, r.Header.Get("Accept-Language"))
+	if base, _ := tag.Base(); base.String() == "ru" {
+		return language.Russian
+	}
+
+	return language.English
+}
+
+// Title returns the title of the kind in lang.
+func (k Kind) Title(lang language.Tag) string {
+	if lang == language.Russian {
+		return k.Russian
+	}
+
+	return k.English
+}
+
+// New describes a problem with the request. detail is returned to the client as is,
+// so it must not carry internal causes; when empty, it is the fixed detail of the kind.
+func New(r *http.Request, status int, kind Kind, detail string) Details {
+	if detail == "" {
+		detail = kind.Detail
+	}
+
+	return Details{
+		Type:     TypePrefix + kind.Code,
+		Title:    kind.Title(Language(r)),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     kind.Code,
+	}
+}
+
+// Write writes the problem as the response.
+func Write(w http.ResponseWriter, r *http.Request, p Details) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Content-Language", Language(r).String())
+	w.WriteHeader(p.Status)
+
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		logger.Warn(err)
+	}
+}
@@ -0,0 +1,59 @@
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestLanguage(t *testing.T) {
+	for header, want := range map[string]language.Tag{
+		"":                           language.English,
+		"ru":                         language.Russian,
+		"ru-RU,ru;q=0.9,en;q=0.8":    language.Russian,
+		"en-US,en;q=0.9,ru;q=0.8":    language.English,
+		"de-DE,de;q=0.9":             language.English,
+		"de;q=0.9,ru;q=0.5,en;q=0.1": language.Russian,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Language", header)
+
+		assert.Equal(t, want, Language(r), header)
+	}
+}
+
+func TestForStatus(t *testing.T) {
+	assert.Equal(t, NotFound, ForStatus(http.StatusNotFound))
+	assert.Equal(t, Internal, ForStatus(http.StatusBadGateway))
+	assert.Equal(t, BadRequest, ForStatus(http.StatusRequestEntityTooLarge))
+}
+
+func TestWrite(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/buy/hat?x=1", nil)
+	r.Header.Set("Accept-Language", "ru")
+	w := httptest.NewRecorder()
+
+	Write(w, r, New(r, http.StatusNotFound, NotFound, "item not found: hat"))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "ru", w.Header().Get("Content-Language"))
+
+	var got Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, Details{
+		Type:     "urn:merch-shop:problem:not_found",
+		Title:    "Не найдено",
+		Status:   http.StatusNotFound,
+		Detail:   "item not found: hat",
+		Instance: "/api/buy/hat",
+		Code:     "not_found",
+	}, got)
+
+	assert.Equal(t, NotFound.Detail, New(r, http.StatusNotFound, NotFound, "").Detail, "the kind has a fixed detail")
+}
@@ -0,0 +1,193 @@
+// Package ratelimit throttles clients with token buckets: every key earns tokens at the
+// rate of its limit up to the burst, and each request spends one.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+// Limit is a token bucket: Rate tokens per second, holding at most Burst.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// NewLimit converts a configured limit. Burst defaults to the requests of one period.
+func NewLimit(cfg config.RateLimit) Limit {
+	if cfg.Requests <= 0 || cfg.Period <= 0 {
+		return Limit{}
+	}
+
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = cfg.Requests
+	}
+
+	return Limit{Rate: float64(cfg.Requests) / cfg.Period.Seconds(), Burst: burst}
+}
+
+// Enabled reports whether the limit throttles anything.
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// Result describes the bucket after a request took, or failed to take, a token.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long a refused client has to wait for the next token.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+// Store keeps the buckets. MemoryStore serves a single instance; deployments with several
+// instances need a shared store (e.g. in Postgres) for the limits to hold across them.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   Limit
+}
+
+// refill brings the bucket up to date.
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.updated = now
+	}
+}
+
+// MemoryStore keeps the buckets in the memory of this instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	b.limit = limit
+	b.refill(now)
+
+	res := Result{Limit: limit.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.tokens) / limit.Rate)
+	}
+
+	res.Remaining = int(b.tokens)
+	res.Reset = seconds((float64(limit.Burst) - b.tokens) / limit.Rate)
+
+	return res, nil
+}
+
+// Run forgets full buckets every interval until ctx is done; they behave like new ones.
+func (s *MemoryStore) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.evict()
+		}
+	}
+}
+
+func (s *MemoryStore) evict() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+type limits struct {
+	fallback Limit
+	routes   map[string]Limit
+}
+
+// Limiter applies the limit of each route to the clients calling it; every client has a
+// bucket per route.
+type Limiter struct {
+	store  Store
+	limits atomic.Pointer[limits]
+}
+
+func NewLimiter(store Store, cfg config.RateLimits) *Limiter {
+	l := &Limiter{store: store}
+	l.SetLimits(cfg)
+
+	return l
+}
+
+// SetLimits replaces the limits on a configuration reload.
+func (l *Limiter) SetLimits(cfg config.RateLimits) {
+	next := &limits{fallback: NewLimit(cfg.Default), routes: make(map[string]Limit, len(cfg.Routes))}
+	for route, limit := range cfg.Routes {
+		next.routes[route] = NewLimit(limit)
+	}
+
+	l.limits.Store(next)
+}
+
+// Take spends a token of the client on the route ("METHOD /pattern"). ok is false when the
+// route is not limited.
+func (l *Limiter) Take(ctx context.Context, route, client string) (res Result, ok bool, err error) {
+	current := l.limits.Load()
+
+	limit, found := current.routes[route]
+	if !found {
+		limit = current.fallback
+	}
+
+	if !limit.Enabled() {
+		return Result{}, false, nil
+	}
+
+	res, err = l.store.Take(ctx, route+" "+client, limit)
+	if err != nil {
+		return Result{}, false, err
+	}
+
+	return res, true, nil
+}
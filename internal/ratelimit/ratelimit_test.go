@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestStore() (*MemoryStore, *clock) {
+	c := &clock{now: time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore()
+	s.now = c.Now
+
+	return s, c
+}
+
+func TestNewLimit(t *testing.T) {
+	assert.Equal(t, Limit{Rate: 0.5, Burst: 30}, NewLimit(config.RateLimit{Requests: 30, Period: time.Minute}))
+	assert.Equal(t, Limit{Rate: 10, Burst: 50}, NewLimit(config.RateLimit{Requests: 10, Period: time.Second, Burst: 50}))
+	assert.False(t, NewLimit(config.RateLimit{}).Enabled())
+	assert.False(t, NewLimit(config.RateLimit{Requests: 10}).Enabled())
+}
+
+func TestMemoryStoreTokenBucket(t *testing.T) {
+	ctx := context.Background()
+	store, clock := newTestStore()
+	limit := Limit{Rate: 1, Burst: 3}
+
+	for remaining := 2; remaining >= 0; remaining-- {
+		res, err := store.Take(ctx, "alice", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, remaining, res.Remaining)
+	}
+
+	res, err := store.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.Reset)
+
+	clock.now = clock.now.Add(1500 * time.Millisecond)
+
+	res, err = store.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "tokens are earned back at the rate of the limit")
+	assert.Equal(t, 0, res.Remaining)
+
+	res, err = store.Take(ctx, "bob", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "every key has a bucket of its own")
+
+	clock.now = clock.now.Add(time.Hour)
+
+	res, err = store.Take(ctx, "alice", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Remaining, "the bucket holds no more than the burst")
+}
+
+func TestMemoryStoreEvictsFullBuckets(t *testing.T) {
+	ctx := context.Background()
+	store, clock := newTestStore()
+
+	_, err := store.Take(ctx, "alice", Limit{Rate: 1, Burst: 10})
+	require.NoError(t, err)
+	_, err = store.Take(ctx, "bob", Limit{Rate: 0.01, Burst: 10})
+	require.NoError(t, err)
+
+	clock.now = clock.now.Add(2 * time.Second)
+	store.evict()
+
+	assert.NotContains(t, store.buckets, "alice")
+	assert.Contains(t, store.buckets, "bob")
+}
+
+func TestLimiterRoutes(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestStore()
+
+	limiter := NewLimiter(store, config.RateLimits{
+		Routes: map[string]config.RateLimit{"POST /api/sendCoin": {Requests: 1, Period: time.Minute}},
+	})
+
+	_, limited, err := limiter.Take(ctx, "GET /api/info", "user:alice")
+	require.NoError(t, err)
+	assert.False(t, limited, "routes without a limit are not throttled")
+
+	res, limited, err := limiter.Take(ctx, "POST /api/sendCoin", "user:alice")
+	require.NoError(t, err)
+	assert.True(t, limited)
+	assert.True(t, res.Allowed)
+
+	res, _, err = limiter.Take(ctx, "POST /api/sendCoin", "user:alice")
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	limiter.SetLimits(config.RateLimits{Default: config.RateLimit{Requests: 5, Period: time.Second}})
+
+	res, limited, err = limiter.Take(ctx, "GET /api/info", "user:alice")
+	require.NoError(t, err)
+	assert.True(t, limited, "reloaded limits apply to the next request")
+	assert.Equal(t, 5, res.Limit)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/db"
+	api "github.com/basedalex/merch-shop/internal/swagger"
+)
+
+// The inline schemas of InfoResponse, which the generator leaves unnamed.
+type (
+	inventoryItem = struct {
+		Quantity *int    `json:"quantity,omitempty"`
+		Type     *string `json:"type,omitempty"`
+	}
+	receivedCoins = struct {
+		Amount   *int    `json:"amount,omitempty"`
+		FromUser *string `json:"fromUser,omitempty"`
+	}
+	sentCoins = struct {
+		Amount *int    `json:"amount,omitempty"`
+		ToUser *string `json:"toUser,omitempty"`
+	}
+	coinHistory = struct {
+		Received *[]receivedCoins `json:"received,omitempty"`
+		Sent     *[]sentCoins     `json:"sent,omitempty"`
+	}
+)
+
+func toInfoResponse(info *db.InfoResponse) api.InfoResponse {
+	inventory := make([]inventoryItem, len(info.Inventory))
+	for i, item := range info.Inventory {
+		inventory[i] = inventoryItem{Quantity: &item.Quantity, Type: &item.Type}
+	}
+
+	received := make([]receivedCoins, len(info.CoinHistory.Received))
+	for i, transaction := range info.CoinHistory.Received {
+		received[i] = receivedCoins{Amount: &transaction.Amount, FromUser: &transaction.FromUser}
+	}
+
+	sent := make([]sentCoins, len(info.CoinHistory.Sent))
+	for i, transaction := range info.CoinHistory.Sent {
+		sent[i] = sentCoins{Amount: &transaction.Amount, ToUser: &transaction.ToUser}
+	}
+
+	return api.InfoResponse{
+		Coins:       &info.Coins,
+		Inventory:   &inventory,
+		CoinHistory: &coinHistory{Received: &received, Sent: &sent},
+	}
+}
+
+func toEmployeeBalances(balances []db.EmployeeBalance) []api.EmployeeBalance {
+	out := make([]api.EmployeeBalance, len(balances))
+	for i, balance := range balances {
+		out[i] = api.EmployeeBalance{Username: balance.Username, Balance: balance.Balance}
+	}
+
+	return out
+}
+
+func toLockoutEvents(events []db.LockoutEvent) []api.LockoutEvent {
+	out := make([]api.LockoutEvent, len(events))
+	for i, event := range events {
+		out[i] = api.LockoutEvent{
+			Id:          event.ID,
+			Key:         event.Key,
+			Failures:    event.Failures,
+			LockedUntil: event.LockedUntil,
+			CreatedAt:   event.CreatedAt,
+		}
+	}
+
+	return out
+}
+
+func toAPIKeys(keys []db.APIKey) []api.APIKey {
+	out := make([]api.APIKey, len(keys))
+	for i, key := range keys {
+		out[i] = api.APIKey{
+			Prefix:     key.Prefix,
+			Account:    key.Account,
+			Scopes:     key.Scopes,
+			ExpiresAt:  key.ExpiresAt,
+			CreatedBy:  key.CreatedBy,
+			CreatedAt:  key.CreatedAt,
+			LastUsedAt: key.LastUsedAt,
+			RevokedAt:  key.RevokedAt,
+		}
+	}
+
+	return out
+}
+
+func toJWKSet(set auth.JWKSet) api.JWKSet {
+	keys := make([]api.JWK, len(set.Keys))
+	for i, key := range set.Keys {
+		keys[i] = api.JWK{
+			Kty: key.KeyType,
+			Kid: key.KeyID,
+			Use: optional(key.Use),
+			Alg: key.Algorithm,
+			N:   optional(key.N),
+			E:   optional(key.E),
+			Crv: optional(key.Curve),
+			X:   optional(key.X),
+		}
+	}
+
+	return api.JWKSet{Keys: keys}
+}
+
+// optional leaves out the empty values of fields the spec does not require.
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
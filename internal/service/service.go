@@ -1,218 +1,1546 @@
 package service
 
 import (
-	"encoding/json"
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/crypto/bcrypt"
 
+	"github.com/basedalex/merch-shop/internal/apikey"
 	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/config"
 	"github.com/basedalex/merch-shop/internal/db"
+	"github.com/basedalex/merch-shop/internal/idempotency"
+	"github.com/basedalex/merch-shop/internal/identity"
+	"github.com/basedalex/merch-shop/internal/lockout"
+	"github.com/basedalex/merch-shop/internal/logging"
+	"github.com/basedalex/merch-shop/internal/metrics"
+	"github.com/basedalex/merch-shop/internal/mfa"
+	"github.com/basedalex/merch-shop/internal/middleware"
+	"github.com/basedalex/merch-shop/internal/password"
+	"github.com/basedalex/merch-shop/internal/problem"
 	api "github.com/basedalex/merch-shop/internal/swagger"
 )
 
+var logger = logging.Logger("service")
+
 //go:generate mockgen -source=service.go -destination=mocks/mock_service.go -package=mocks
 
 type Service interface {
-	PostApiAuth(w http.ResponseWriter, r *http.Request)
-	GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string)
-	GetApiInfo(w http.ResponseWriter, r *http.Request)
-	PostApiSendCoin(w http.ResponseWriter, r *http.Request)
+	PostApiAuth(ctx context.Context, request api.PostApiAuthRequestObject) (api.PostApiAuthResponseObject, error)
+	PostApiAuthRefresh(ctx context.Context, request api.PostApiAuthRefreshRequestObject) (api.PostApiAuthRefreshResponseObject, error)
+	GetApiAuthOidcLogin(ctx context.Context, request api.GetApiAuthOidcLoginRequestObject) (api.GetApiAuthOidcLoginResponseObject, error)
+	GetApiAuthOidcCallback(ctx context.Context, request api.GetApiAuthOidcCallbackRequestObject) (api.GetApiAuthOidcCallbackResponseObject, error)
+	PostApiRegister(ctx context.Context, request api.PostApiRegisterRequestObject) (api.PostApiRegisterResponseObject, error)
+	PostApiAdminInvites(ctx context.Context, request api.PostApiAdminInvitesRequestObject) (api.PostApiAdminInvitesResponseObject, error)
+	PostApiAdminEmployees(ctx context.Context, request api.PostApiAdminEmployeesRequestObject) (api.PostApiAdminEmployeesResponseObject, error)
+	PostApiAuthLogout(ctx context.Context, request api.PostApiAuthLogoutRequestObject) (api.PostApiAuthLogoutResponseObject, error)
+	DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameSessionsRequestObject) (api.DeleteApiAdminEmployeesUsernameSessionsResponseObject, error)
+	PutApiAdminEmployeesUsernameRole(ctx context.Context, request api.PutApiAdminEmployeesUsernameRoleRequestObject) (api.PutApiAdminEmployeesUsernameRoleResponseObject, error)
+	GetApiAdminLockouts(ctx context.Context, request api.GetApiAdminLockoutsRequestObject) (api.GetApiAdminLockoutsResponseObject, error)
+	PostApiAuthMfa(ctx context.Context, request api.PostApiAuthMfaRequestObject) (api.PostApiAuthMfaResponseObject, error)
+	PostApiMfaEnroll(ctx context.Context, request api.PostApiMfaEnrollRequestObject) (api.PostApiMfaEnrollResponseObject, error)
+	PostApiMfaConfirm(ctx context.Context, request api.PostApiMfaConfirmRequestObject) (api.PostApiMfaConfirmResponseObject, error)
+	PostApiMfaDisable(ctx context.Context, request api.PostApiMfaDisableRequestObject) (api.PostApiMfaDisableResponseObject, error)
+	PostApiPassword(ctx context.Context, request api.PostApiPasswordRequestObject) (api.PostApiPasswordResponseObject, error)
+	PostApiCoinsGrant(ctx context.Context, request api.PostApiCoinsGrantRequestObject) (api.PostApiCoinsGrantResponseObject, error)
+	PutApiCatalogItem(ctx context.Context, request api.PutApiCatalogItemRequestObject) (api.PutApiCatalogItemResponseObject, error)
+	GetApiReportsBalances(ctx context.Context, request api.GetApiReportsBalancesRequestObject) (api.GetApiReportsBalancesResponseObject, error)
+	PostApiAdminServiceAccounts(ctx context.Context, request api.PostApiAdminServiceAccountsRequestObject) (api.PostApiAdminServiceAccountsResponseObject, error)
+	GetApiAdminServiceAccountsNameKeys(ctx context.Context, request api.GetApiAdminServiceAccountsNameKeysRequestObject) (api.GetApiAdminServiceAccountsNameKeysResponseObject, error)
+	PostApiAdminServiceAccountsNameKeys(ctx context.Context, request api.PostApiAdminServiceAccountsNameKeysRequestObject) (api.PostApiAdminServiceAccountsNameKeysResponseObject, error)
+	DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, request api.DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject) (api.DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject, error)
+	PostApiPasswordReset(ctx context.Context, request api.PostApiPasswordResetRequestObject) (api.PostApiPasswordResetResponseObject, error)
+	PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, request api.PostApiAdminEmployeesUsernamePasswordResetRequestObject) (api.PostApiAdminEmployeesUsernamePasswordResetResponseObject, error)
+	DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameLockoutRequestObject) (api.DeleteApiAdminEmployeesUsernameLockoutResponseObject, error)
+	GetWellKnownJwksJson(ctx context.Context, request api.GetWellKnownJwksJsonRequestObject) (api.GetWellKnownJwksJsonResponseObject, error)
+	GetApiBuyItem(ctx context.Context, request api.GetApiBuyItemRequestObject) (api.GetApiBuyItemResponseObject, error)
+	GetApiInfo(ctx context.Context, request api.GetApiInfoRequestObject) (api.GetApiInfoResponseObject, error)
+	PostApiSendCoin(ctx context.Context, request api.PostApiSendCoinRequestObject) (api.PostApiSendCoinResponseObject, error)
+}
+
+// Revoker takes revoked tokens out of service immediately on this instance,
+// without waiting for the next denylist sync.
+type Revoker interface {
+	Revoke(jti string, expiresAt time.Time)
+	RevokeUser(username string, before time.Time)
 }
 
+// defaultInviteTTL applies when auth.registration.inviteTTL is not configured.
+const defaultInviteTTL = 7 * 24 * time.Hour
+
+// defaultPasswordResetTTL applies when auth.password.resetTTL is not configured.
+const defaultPasswordResetTTL = time.Hour
+
+// defaultMFAIssuer applies when auth.mfa.issuer is not configured.
+const defaultMFAIssuer = "merch-shop"
+
+// defaultSSOLoginTTL applies when auth.oidc.loginTTL is not configured.
+const defaultSSOLoginTTL = 10 * time.Minute
+
+// ssoStateCookie binds a single-sign-on callback to the browser that started the login.
+const ssoStateCookie = "merch_shop_sso_state"
+
+var (
+	errLockedOut        = errors.New("too many failed login attempts")
+	errSSONotConfigured = errors.New("single sign-on is not configured")
+	errNotAuthenticated = errors.New("request is not authenticated")
+)
+
 type MyService struct {
-	db db.Repository
+	db        db.Repository
+	revoker   Revoker
+	lockout   *lockout.Guard
+	passwords *password.Policy
+	local     identity.PasswordProvider
+	sso       identity.RedirectProvider
+
+	settings    atomic.Pointer[settings]
+	resetTTL    time.Duration
+	ssoLoginTTL time.Duration
+}
+
+// settings are the parts of the configuration that are reloaded while the service runs.
+type settings struct {
+	registrationMode     string
+	inviteTTL            time.Duration
+	mfaIssuer            string
+	mfaTransferThreshold int
+}
+
+// AccessPolicy lists the routes that need more than the employee role.
+var AccessPolicy = middleware.Policy{
+	"POST /api/admin/invites":                                 {auth.RoleFinanceAdmin},
+	"POST /api/admin/employees":                               {auth.RoleFinanceAdmin},
+	"POST /api/admin/employees/{username}/password-reset":     {auth.RoleFinanceAdmin},
+	"GET /api/admin/lockouts":                                 {auth.RoleFinanceAdmin},
+	"DELETE /api/admin/employees/{username}/lockout":          {auth.RoleFinanceAdmin},
+	"DELETE /api/admin/employees/{username}/sessions":         {auth.RoleFinanceAdmin},
+	"PUT /api/admin/employees/{username}/role":                {auth.RoleFinanceAdmin},
+	"POST /api/admin/service-accounts":                        {auth.RoleFinanceAdmin},
+	"GET /api/admin/service-accounts/{name}/keys":             {auth.RoleFinanceAdmin},
+	"POST /api/admin/service-accounts/{name}/keys":            {auth.RoleFinanceAdmin},
+	"DELETE /api/admin/service-accounts/{name}/keys/{prefix}": {auth.RoleFinanceAdmin},
+	"POST /api/coins/grant":                                   {auth.RoleFinanceAdmin},
+	"PUT /api/catalog/{item}":                                 {auth.RoleShopManager},
+	"GET /api/reports/balances":                               {auth.RoleFinanceAdmin},
+}
+
+// IdempotentRoutes take an Idempotency-Key: the ledger changes, which are safe to replay.
+// Routes that answer with secrets (enrollment, API keys, reset tokens, invites) stay out,
+// as their replays would need the secret stored in plaintext.
+var IdempotentRoutes = middleware.RouteSet{
+	"POST /api/sendCoin":    true,
+	"GET /api/buy/{item}":   true,
+	"POST /api/coins/grant": true,
+}
+
+// ScopePolicy lists the routes service accounts can call with an API key.
+var ScopePolicy = middleware.ScopePolicy{
+	"POST /api/coins/grant":     auth.ScopeCoinsGrant,
+	"PUT /api/catalog/{item}":   auth.ScopeCatalogWrite,
+	"GET /api/reports/balances": auth.ScopeReadReports,
 }
 
 // (POST /api/auth).
-func (s *MyService) PostApiAuth(w http.ResponseWriter, r *http.Request) {
-	body, err := io.ReadAll(r.Body)
+func (s *MyService) PostApiAuth(ctx context.Context, request api.PostApiAuthRequestObject) (api.PostApiAuthResponseObject, error) {
+	authRequest := *request.Body
+
+	retryAfter, err := s.checkLockout(ctx, authRequest.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if retryAfter > 0 {
+		return api.PostApiAuth429ApplicationProblemPlusJSONResponse{
+			Body:    newProblem(ctx, errLockedOut, http.StatusTooManyRequests),
+			Headers: api.PostApiAuth429ResponseHeaders{RetryAfter: retryAfter},
+		}, nil
+	}
+
+	// if user exists and password is right give back token
+	id, err := s.local.Authenticate(ctx, authRequest.Username, authRequest.Password)
+
 	if err != nil {
-		writeErrResponse(w, err, http.StatusBadRequest)
+		logger.Warn(err)
+	}
+
+	switch {
+	case err == nil:
+		tokens, err := s.firstFactorLogin(ctx, id.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		return api.PostApiAuth200JSONResponse(tokens), nil
+	case errors.Is(err, identity.ErrInvalidCredentials):
+		s.recordAuthFailure(ctx, metrics.FactorPassword, authRequest.Username)
 
-		return
+		return api.PostApiAuth401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	case !errors.Is(err, identity.ErrUnknownEmployee):
+		return nil, err
 	}
 
-	defer r.Body.Close()
+	// unknown employees are only created on the fly in the assignment-compatible mode
+	if s.settings.Load().registrationMode != config.RegistrationImplicit {
+		// the same answer, in the same time, as for a wrong password: usernames cannot be probed
+		s.passwords.CompareDummy(authRequest.Password)
+		s.recordAuthFailure(ctx, metrics.FactorPassword, authRequest.Username)
 
-	var authRequest api.AuthRequest
+		return api.PostApiAuth401ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("%w: %w", identity.ErrInvalidCredentials, err), http.StatusUnauthorized)), nil
+	}
 
-	err = json.Unmarshal(body, &authRequest)
+	// the password policy is not applied here: clients of the implicit mode
+	// expect any password to be accepted on first login
+	hashedPassword, err := s.passwords.Hash(authRequest.Password)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusBadRequest)
+		return nil, err
+	}
 
-		return
+	authRequest.Password = hashedPassword
+
+	if err = s.db.CreateEmployee(ctx, authRequest); err != nil {
+		return nil, fmt.Errorf("could not create new employee %w", err)
 	}
 
-	// if user exists and password is right give back token
-	exists, err := s.db.Authenticate(r.Context(), authRequest)
+	tokens, err := s.issueTokens(ctx, authRequest.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostApiAuth200JSONResponse(tokens), nil
+}
 
+// (GET /api/auth/oidc/login).
+func (s *MyService) GetApiAuthOidcLogin(ctx context.Context, _ api.GetApiAuthOidcLoginRequestObject) (api.GetApiAuthOidcLoginResponseObject, error) {
+	if s.sso == nil {
+		return api.GetApiAuthOidcLogin404ApplicationProblemPlusJSONResponse(newProblem(ctx, errSSONotConfigured, http.StatusNotFound)), nil
+	}
+
+	login, err := identity.NewLogin()
 	if err != nil {
-		log.Warn(err)
+		return nil, err
 	}
 
-	if err != nil && exists {
-		writeErrResponse(w, fmt.Errorf("error: credentials are incorrect %w", err), http.StatusUnauthorized)
+	err = s.db.CreateOIDCLogin(ctx, db.OIDCLogin{
+		StateHash:    auth.HashOpaqueToken(login.State),
+		Nonce:        login.Nonce,
+		CodeVerifier: login.Verifier,
+		ExpiresAt:    time.Now().Add(s.ssoLoginTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		return
+	cookie := &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    login.State,
+		Path:     "/api/auth/oidc",
+		MaxAge:   int(s.ssoLoginTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   httpRequest(ctx).TLS != nil,
+		SameSite: http.SameSiteLaxMode,
 	}
 
-	if exists {
-		token, err := auth.CreateToken(authRequest.Username)
+	return api.GetApiAuthOidcLogin302Response{Headers: api.GetApiAuthOidcLogin302ResponseHeaders{
+		Location:  s.sso.AuthCodeURL(*login),
+		SetCookie: cookie.String(),
+	}}, nil
+}
+
+// (GET /api/auth/oidc/callback).
+func (s *MyService) GetApiAuthOidcCallback(ctx context.Context, request api.GetApiAuthOidcCallbackRequestObject) (api.GetApiAuthOidcCallbackResponseObject, error) {
+	params := request.Params
 
-		if err != nil {
-			writeErrResponse(w, err, http.StatusInternalServerError)
+	if s.sso == nil {
+		return api.GetApiAuthOidcCallback404ApplicationProblemPlusJSONResponse(newProblem(ctx, errSSONotConfigured, http.StatusNotFound)), nil
+	}
+
+	if params.Error != nil {
+		return api.GetApiAuthOidcCallback401ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("single sign-on failed: %s", *params.Error), http.StatusUnauthorized)), nil
+	}
+
+	if params.Code == nil || params.State == nil {
+		return api.GetApiAuthOidcCallback400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("code and state are required"), http.StatusBadRequest)), nil
+	}
+
+	// a state that did not come from this browser is a login forced on the employee by someone else
+	cookie, err := httpRequest(ctx).Cookie(ssoStateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(*params.State)) != 1 {
+		return api.GetApiAuthOidcCallback400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("single sign-on state does not match this browser"), http.StatusBadRequest)), nil
+	}
+
+	pending, err := s.db.TakeOIDCLogin(ctx, auth.HashOpaqueToken(*params.State))
+	if err != nil {
+		if errors.Is(err, db.ErrOIDCLoginInvalid) {
+			return api.GetApiAuthOidcCallback400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+		}
+
+		return nil, err
+	}
+
+	id, err := s.sso.Exchange(ctx, *params.Code, identity.Login{
+		State:    *params.State,
+		Nonce:    pending.Nonce,
+		Verifier: pending.CodeVerifier,
+	})
+	if err != nil {
+		logger.Warn(err)
+
+		if errors.Is(err, identity.ErrInvalidCredentials) {
+			return api.GetApiAuthOidcCallback401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+		}
 
-			return
+		return nil, err
+	}
+
+	username, err := s.db.LinkIdentity(ctx, db.ExternalIdentity{
+		Issuer:   id.Issuer,
+		Subject:  id.Subject,
+		Username: id.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrIdentityNotLinked) || errors.Is(err, db.ErrIdentityConflict) {
+			logger.WithFields(log.Fields{"issuer": id.Issuer, "subject": id.Subject, "claimed": id.Username}).Warn(err)
+
+			return api.GetApiAuthOidcCallback403ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusForbidden)), nil
+		}
+
+		return nil, err
+	}
+
+	tokens, err := s.firstFactorLogin(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := &http.Cookie{Name: ssoStateCookie, Path: "/api/auth/oidc", MaxAge: -1}
+
+	return api.GetApiAuthOidcCallback200JSONResponse{
+		Body:    tokens,
+		Headers: api.GetApiAuthOidcCallback200ResponseHeaders{SetCookie: expired.String()},
+	}, nil
+}
+
+// (POST /api/auth/refresh).
+func (s *MyService) PostApiAuthRefresh(ctx context.Context, request api.PostApiAuthRefreshRequestObject) (api.PostApiAuthRefreshResponseObject, error) {
+	refreshRequest := *request.Body
+
+	if refreshRequest.RefreshToken == "" {
+		return api.PostApiAuthRefresh400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("refresh token is required"), http.StatusBadRequest)), nil
+	}
+
+	refreshToken, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := s.db.RotateRefreshToken(ctx, auth.HashOpaqueToken(refreshRequest.RefreshToken), db.RefreshToken{
+		Hash:      hash,
+		ExpiresAt: time.Now().Add(auth.RefreshTokenTTL),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRefreshTokenNotFound) || errors.Is(err, db.ErrRefreshTokenExpired) ||
+			errors.Is(err, db.ErrRefreshTokenRevoked) || errors.Is(err, db.ErrRefreshTokenReused) {
+			return api.PostApiAuthRefresh401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
 		}
 
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, token)
-		return
+		return nil, err
 	}
 
-	// if user doesn't exist create one
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(authRequest.Password), bcrypt.DefaultCost)
+	token, err := s.createToken(ctx, username)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return api.PostApiAuthRefresh200JSONResponse{Token: &token, RefreshToken: &refreshToken}, nil
+}
 
-		return
+// (POST /api/register).
+func (s *MyService) PostApiRegister(ctx context.Context, request api.PostApiRegisterRequestObject) (api.PostApiRegisterResponseObject, error) {
+	mode := s.settings.Load().registrationMode
+	if mode == config.RegistrationAdmin {
+		return api.PostApiRegister403ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("self-registration is disabled"), http.StatusForbidden)), nil
+	}
+
+	registerRequest := *request.Body
+
+	if registerRequest.Username == "" || registerRequest.Password == "" {
+		return api.PostApiRegister400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("username and password are required"), http.StatusBadRequest)), nil
+	}
+
+	if err := s.passwords.Validate(registerRequest.Password); err != nil {
+		return api.PostApiRegister400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+	}
+
+	hashedPassword, err := s.passwords.Hash(registerRequest.Password)
+	if err != nil {
+		return nil, err
 	}
 
-	authRequest.Password = string(hashedPassword)
+	authRequest := api.AuthRequest{Username: registerRequest.Username, Password: hashedPassword}
 
-	if err = s.db.CreateEmployee(r.Context(), authRequest); err != nil {
-		writeErrResponse(w, fmt.Errorf("could not create new employee %w", err), http.StatusInternalServerError)
+	if mode == config.RegistrationInvite {
+		if registerRequest.InviteCode == nil || *registerRequest.InviteCode == "" {
+			return api.PostApiRegister403ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("invite code is required"), http.StatusForbidden)), nil
+		}
 
-		return
+		err = s.db.CreateEmployeeWithInvite(ctx, authRequest, auth.HashOpaqueToken(*registerRequest.InviteCode))
+	} else {
+		err = s.db.CreateEmployee(ctx, authRequest)
 	}
 
-	token, err := auth.CreateToken(authRequest.Username)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, db.ErrInviteInvalid):
+			return api.PostApiRegister403ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusForbidden)), nil
+		case errors.Is(err, db.ErrEmployeeExists):
+			return api.PostApiRegister409ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusConflict)), nil
+		default:
+			return nil, fmt.Errorf("could not create new employee %w", err)
+		}
+	}
 
-		return
+	tokens, err := s.issueTokens(ctx, authRequest.Username)
+	if err != nil {
+		return nil, err
 	}
 
-	w.WriteHeader(http.StatusOK)
-	fmt.Fprint(w, token)
+	return api.PostApiRegister200JSONResponse(tokens), nil
 }
 
-// (GET /api/buy/{item}).
-func (s *MyService) GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string) {
-	username, err := getLoginFromToken(r.Header.Get("Authorization"))
+// (POST /api/admin/invites).
+func (s *MyService) PostApiAdminInvites(ctx context.Context, _ api.PostApiAdminInvitesRequestObject) (api.PostApiAdminInvitesResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiAdminInvites401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	code, hash, err := auth.NewOpaqueToken()
 	if err != nil {
-		writeErrResponse(w, err, http.StatusUnauthorized)
-		return
+		return nil, err
 	}
 
-	if err = s.db.BuyItem(r.Context(), username, item); err != nil {
-		writeErrResponse(w, err, http.StatusInternalServerError)
+	expiresAt := time.Now().Add(s.settings.Load().inviteTTL)
 
-		return
+	if err = s.db.CreateInvite(ctx, db.Invite{Hash: hash, CreatedBy: admin, ExpiresAt: expiresAt}); err != nil {
+		return nil, err
 	}
 
-	writeOkResponse(w, http.StatusOK, nil)
+	return api.PostApiAdminInvites200JSONResponse{Code: code, ExpiresAt: expiresAt}, nil
 }
 
-// (GET /api/info).
-func (s *MyService) GetApiInfo(w http.ResponseWriter, r *http.Request) {
-	username, err := getLoginFromToken(r.Header.Get("Authorization"))
+// (POST /api/admin/employees).
+func (s *MyService) PostApiAdminEmployees(ctx context.Context, request api.PostApiAdminEmployeesRequestObject) (api.PostApiAdminEmployeesResponseObject, error) {
+	admin, err := loginFromContext(ctx)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusUnauthorized)
-		return
+		return api.PostApiAdminEmployees401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	createRequest := *request.Body
+
+	if createRequest.Username == "" || createRequest.Password == "" {
+		return api.PostApiAdminEmployees400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("username and password are required"), http.StatusBadRequest)), nil
+	}
+
+	role := auth.RoleEmployee
+	if createRequest.Role != nil {
+		role = auth.Role(*createRequest.Role)
+	}
+
+	if !role.Valid() {
+		return api.PostApiAdminEmployees400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("unknown role %q", role), http.StatusBadRequest)), nil
 	}
 
-	infoResponse, err := s.db.GetEmployeeInfo(r.Context(), username)
+	if err = s.passwords.Validate(createRequest.Password); err != nil {
+		return api.PostApiAdminEmployees400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+	}
+
+	hashedPassword, err := s.passwords.Hash(createRequest.Password)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	authRequest := api.AuthRequest{Username: createRequest.Username, Password: hashedPassword}
+	if err = s.db.CreateEmployeeWithRole(ctx, authRequest, string(role)); err != nil {
+		if errors.Is(err, db.ErrEmployeeExists) {
+			return api.PostApiAdminEmployees409ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusConflict)), nil
+		}
 
-		return
+		return nil, fmt.Errorf("could not create new employee %w", err)
 	}
 
-	writeOkResponse(w, http.StatusOK, infoResponse)
+	logger.WithFields(log.Fields{"admin": admin, "username": createRequest.Username, "role": role}).Info("employee provisioned")
+
+	return api.PostApiAdminEmployees200Response{}, nil
 }
 
-// (POST /api/sendCoin).
-func (s *MyService) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
-	body, err := io.ReadAll(r.Body)
+// (POST /api/auth/logout).
+func (s *MyService) PostApiAuthLogout(ctx context.Context, request api.PostApiAuthLogoutRequestObject) (api.PostApiAuthLogoutResponseObject, error) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		return api.PostApiAuthLogout401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	revoked := db.RevokedToken{JTI: claims.Id, ExpiresAt: claims.ExpiresAtTime()}
+	if err = s.db.RevokeToken(ctx, revoked, claims.Username); err != nil {
+		return nil, err
+	}
+
+	s.revoker.Revoke(revoked.JTI, revoked.ExpiresAt)
+
+	if request.Body != nil && request.Body.RefreshToken != nil && *request.Body.RefreshToken != "" {
+		hash := auth.HashOpaqueToken(*request.Body.RefreshToken)
+		if err = s.db.RevokeRefreshTokenFamily(ctx, hash, claims.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	return api.PostApiAuthLogout200Response{}, nil
+}
+
+// (DELETE /api/admin/employees/{username}/sessions).
+func (s *MyService) DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameSessionsRequestObject) (api.DeleteApiAdminEmployeesUsernameSessionsResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.DeleteApiAdminEmployeesUsernameSessions401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	revokedBefore, err := s.db.RevokeAllSessions(ctx, request.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrEmployeeNotFound) {
+			return api.DeleteApiAdminEmployeesUsernameSessions404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
+
+		return nil, err
+	}
+
+	s.revoker.RevokeUser(request.Username, revokedBefore)
+	logger.WithFields(log.Fields{"admin": admin, "username": request.Username}).Info("all sessions revoked")
+
+	return api.DeleteApiAdminEmployeesUsernameSessions200Response{}, nil
+}
+
+// (PUT /api/admin/employees/{username}/role).
+func (s *MyService) PutApiAdminEmployeesUsernameRole(ctx context.Context, request api.PutApiAdminEmployeesUsernameRoleRequestObject) (api.PutApiAdminEmployeesUsernameRoleResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PutApiAdminEmployeesUsernameRole401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	role := auth.Role(request.Body.Role)
+	if !role.Valid() {
+		return api.PutApiAdminEmployeesUsernameRole400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("unknown role %q", request.Body.Role), http.StatusBadRequest)), nil
+	}
+
+	if err = s.db.SetEmployeeRole(ctx, request.Username, string(role)); err != nil {
+		if errors.Is(err, db.ErrEmployeeNotFound) {
+			return api.PutApiAdminEmployeesUsernameRole404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
+
+		return nil, err
+	}
+
+	// the role is carried by the access tokens, so the ones issued with the old role must go
+	revokedBefore, err := s.db.RevokeAllSessions(ctx, request.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	s.revoker.RevokeUser(request.Username, revokedBefore)
+	logger.WithFields(log.Fields{"admin": admin, "username": request.Username, "role": role}).Info("employee role changed")
+
+	return api.PutApiAdminEmployeesUsernameRole200Response{}, nil
+}
+
+// (POST /api/auth/mfa).
+func (s *MyService) PostApiAuthMfa(ctx context.Context, request api.PostApiAuthMfaRequestObject) (api.PostApiAuthMfaResponseObject, error) {
+	mfaRequest := *request.Body
+
+	claims, err := auth.ParseMFAToken(mfaRequest.MfaToken)
+	if err != nil {
+		return api.PostApiAuthMfa401ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("error parsing mfa token %w", err), http.StatusUnauthorized)), nil
+	}
+
+	retryAfter, err := s.checkLockout(ctx, claims.Username)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusBadRequest)
+		return nil, err
+	}
 
-		return
+	if retryAfter > 0 {
+		return api.PostApiAuthMfa429ApplicationProblemPlusJSONResponse{
+			Body:    newProblem(ctx, errLockedOut, http.StatusTooManyRequests),
+			Headers: api.PostApiAuthMfa429ResponseHeaders{RetryAfter: retryAfter},
+		}, nil
 	}
 
-	defer r.Body.Close()
+	if err = s.verifyMFACode(ctx, claims.Username, mfaRequest.Code); err != nil {
+		if errors.Is(err, db.ErrMFACodeInvalid) || errors.Is(err, db.ErrMFANotFound) {
+			s.recordAuthFailure(ctx, metrics.FactorMFA, claims.Username)
+
+			return api.PostApiAuthMfa401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+		}
+
+		return nil, err
+	}
 
-	var sendCoinRequest api.SendCoinRequest
+	if err = s.lockout.Succeed(ctx, claims.Username); err != nil {
+		logger.Warn(err)
+	}
 
-	err = json.Unmarshal(body, &sendCoinRequest)
+	tokens, err := s.issueTokens(ctx, claims.Username)
 	if err != nil {
-		writeErrResponse(w, err, http.StatusBadRequest)
+		return nil, err
+	}
+
+	return api.PostApiAuthMfa200JSONResponse(tokens), nil
+}
 
-		return
+// (POST /api/mfa/enroll).
+func (s *MyService) PostApiMfaEnroll(ctx context.Context, _ api.PostApiMfaEnrollRequestObject) (api.PostApiMfaEnrollResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiMfaEnroll401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
 	}
 
-	username, err := getLoginFromToken(r.Header.Get("Authorization"))
+	secret, err := mfa.NewSecret()
 	if err != nil {
-		writeErrResponse(w, err, http.StatusUnauthorized)
-		return
+		return nil, err
 	}
 
-	err = s.db.TransferCoins(r.Context(), username, sendCoinRequest.ToUser, sendCoinRequest.Amount)
+	recoveryCodes, err := mfa.NewRecoveryCodes()
 	if err != nil {
-		writeErrResponse(w, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	recoveryHashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		recoveryHashes[i] = auth.HashOpaqueToken(code)
+	}
+
+	if err = s.db.EnrollMFA(ctx, username, secret, recoveryHashes); err != nil {
+		if errors.Is(err, db.ErrMFAEnabled) {
+			return api.PostApiMfaEnroll409ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusConflict)), nil
+		}
 
-		return
+		return nil, err
 	}
 
-	writeOkResponse(w, http.StatusOK, nil)
+	return api.PostApiMfaEnroll200JSONResponse{
+		Secret:        secret,
+		OtpauthUri:    mfa.URI(s.settings.Load().mfaIssuer, username, secret),
+		RecoveryCodes: recoveryCodes,
+	}, nil
 }
 
-func NewService(db db.Repository) *MyService {
-	return &MyService{
-		db: db,
+// (POST /api/mfa/confirm).
+func (s *MyService) PostApiMfaConfirm(ctx context.Context, request api.PostApiMfaConfirmRequestObject) (api.PostApiMfaConfirmResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiMfaConfirm401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	enrollment, err := s.db.GetMFA(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrMFANotFound) {
+			return api.PostApiMfaConfirm400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+		}
+
+		return nil, err
+	}
+
+	if enrollment.Confirmed {
+		return api.PostApiMfaConfirm400ApplicationProblemPlusJSONResponse(newProblem(ctx, db.ErrMFAEnabled, http.StatusBadRequest)), nil
+	}
+
+	step, ok := mfa.Verify(enrollment.Secret, request.Body.Code, time.Now())
+	if !ok {
+		return api.PostApiMfaConfirm400ApplicationProblemPlusJSONResponse(newProblem(ctx, db.ErrMFACodeInvalid, http.StatusBadRequest)), nil
+	}
+
+	if err = s.db.ConfirmMFA(ctx, username, step); err != nil {
+		if errors.Is(err, db.ErrMFANotFound) {
+			return api.PostApiMfaConfirm400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+		}
+
+		return nil, err
 	}
+
+	logger.WithField("username", username).Info("two-factor authentication enabled")
+
+	return api.PostApiMfaConfirm200Response{}, nil
 }
 
-type HTTPResponse struct {
-	Data  any    `json:"data,omitempty"`
-	Error string `json:"error,omitempty"`
+// (POST /api/mfa/disable).
+func (s *MyService) PostApiMfaDisable(ctx context.Context, request api.PostApiMfaDisableRequestObject) (api.PostApiMfaDisableResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiMfaDisable401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	retryAfter, err := s.checkLockout(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if retryAfter > 0 {
+		return api.PostApiMfaDisable429ApplicationProblemPlusJSONResponse{
+			Body:    newProblem(ctx, errLockedOut, http.StatusTooManyRequests),
+			Headers: api.PostApiMfaDisable429ResponseHeaders{RetryAfter: retryAfter},
+		}, nil
+	}
+
+	if err = s.verifyMFACode(ctx, username, request.Body.Code); err != nil {
+		if errors.Is(err, db.ErrMFACodeInvalid) || errors.Is(err, db.ErrMFANotFound) {
+			s.recordAuthFailure(ctx, metrics.FactorMFA, username)
+
+			return api.PostApiMfaDisable400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+		}
+
+		return nil, err
+	}
+
+	if err = s.db.DisableMFA(ctx, username); err != nil {
+		return nil, err
+	}
+
+	logger.WithField("username", username).Info("two-factor authentication disabled")
+
+	return api.PostApiMfaDisable200Response{}, nil
 }
 
-func getLoginFromToken(tokenString string) (string, error) {
-	token := strings.TrimPrefix(tokenString, "Bearer ")
+// (POST /api/password).
+func (s *MyService) PostApiPassword(ctx context.Context, request api.PostApiPasswordRequestObject) (api.PostApiPasswordResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiPassword401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	changeRequest := *request.Body
+
+	// a stolen access token must not give unlimited guesses at the current password
+	retryAfter, err := s.checkLockout(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if retryAfter > 0 {
+		return api.PostApiPassword429ApplicationProblemPlusJSONResponse{
+			Body:    newProblem(ctx, errLockedOut, http.StatusTooManyRequests),
+			Headers: api.PostApiPassword429ResponseHeaders{RetryAfter: retryAfter},
+		}, nil
+	}
+
+	exists, err := s.db.Authenticate(ctx, api.AuthRequest{Username: username, Password: changeRequest.CurrentPassword})
+	if err != nil && exists {
+		s.recordAuthFailure(ctx, metrics.FactorPassword, username)
+
+		return api.PostApiPassword401ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("error: current password is incorrect"), http.StatusUnauthorized)), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return api.PostApiPassword401ApplicationProblemPlusJSONResponse(newProblem(ctx, db.ErrEmployeeNotFound, http.StatusUnauthorized)), nil
+	}
+
+	if err = s.passwords.Validate(changeRequest.NewPassword); err != nil {
+		return api.PostApiPassword400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+	}
+
+	hashedPassword, err := s.passwords.Hash(changeRequest.NewPassword)
+	if err != nil {
+		return nil, err
+	}
 
-	username, err := auth.ExtractUsername(token)
+	revokedBefore, err := s.db.ChangePassword(ctx, username, hashedPassword)
 	if err != nil {
-		return "", fmt.Errorf("error extracting username %w", err)
+		return nil, err
 	}
 
-	return username, nil
+	s.revoker.RevokeUser(username, revokedBefore)
+
+	logger.WithField("username", username).Info("password changed")
+
+	return api.PostApiPassword200Response{}, nil
 }
 
-func writeOkResponse(w http.ResponseWriter, statusCode int, data any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+// (POST /api/password/reset).
+func (s *MyService) PostApiPasswordReset(ctx context.Context, request api.PostApiPasswordResetRequestObject) (api.PostApiPasswordResetResponseObject, error) {
+	resetRequest := *request.Body
+
+	if resetRequest.ResetToken == "" {
+		return api.PostApiPasswordReset400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("reset token is required"), http.StatusBadRequest)), nil
+	}
+
+	if err := s.passwords.Validate(resetRequest.NewPassword); err != nil {
+		return api.PostApiPasswordReset400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+	}
+
+	hashedPassword, err := s.passwords.Hash(resetRequest.NewPassword)
+	if err != nil {
+		return nil, err
+	}
 
-	err := json.NewEncoder(w).Encode(HTTPResponse{Data: data})
+	username, revokedBefore, err := s.db.ResetPassword(ctx, auth.HashOpaqueToken(resetRequest.ResetToken), hashedPassword)
 	if err != nil {
-		log.Warn(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		if errors.Is(err, db.ErrPasswordResetInvalid) {
+			return api.PostApiPasswordReset400ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusBadRequest)), nil
+		}
+
+		return nil, err
 	}
+
+	s.revoker.RevokeUser(username, revokedBefore)
+
+	logger.WithField("username", username).Info("password reset")
+
+	return api.PostApiPasswordReset200Response{}, nil
 }
 
-func writeErrResponse(w http.ResponseWriter, err error, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	log.Warn(err)
+// (POST /api/admin/employees/{username}/password-reset).
+func (s *MyService) PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, request api.PostApiAdminEmployeesUsernamePasswordResetRequestObject) (api.PostApiAdminEmployeesUsernamePasswordResetResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiAdminEmployeesUsernamePasswordReset401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	resetToken, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.resetTTL)
+
+	err = s.db.CreatePasswordReset(ctx, db.PasswordReset{
+		Hash:      hash,
+		Username:  request.Username,
+		CreatedBy: admin,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrEmployeeNotFound) {
+			return api.PostApiAdminEmployeesUsernamePasswordReset404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
 
-	jsonErr := json.NewEncoder(w).Encode(HTTPResponse{Error: err.Error()})
-	if jsonErr != nil {
-		log.Warn(jsonErr)
+		return nil, err
 	}
+
+	logger.WithFields(log.Fields{"admin": admin, "username": request.Username}).Info("password reset issued")
+
+	return api.PostApiAdminEmployeesUsernamePasswordReset200JSONResponse{ResetToken: resetToken, ExpiresAt: expiresAt}, nil
+}
+
+// (POST /api/coins/grant).
+func (s *MyService) PostApiCoinsGrant(ctx context.Context, request api.PostApiCoinsGrantRequestObject) (api.PostApiCoinsGrantResponseObject, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return api.PostApiCoinsGrant401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	grantRequest := *request.Body
+
+	if grantRequest.ToUser == "" || grantRequest.Amount <= 0 {
+		return api.PostApiCoinsGrant400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("receiver and a positive amount are required"), http.StatusBadRequest)), nil
+	}
+
+	grant := db.CoinGrant{Receiver: grantRequest.ToUser, Amount: grantRequest.Amount, GrantedBy: actor}
+	if grantRequest.Reason != nil {
+		grant.Reason = *grantRequest.Reason
+	}
+
+	prepareOkResponse(ctx)
+
+	if err = s.db.GrantCoins(ctx, grant); err != nil {
+		if errors.Is(err, db.ErrEmployeeNotFound) {
+			return api.PostApiCoinsGrant404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
+
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"by": actor, "username": grant.Receiver, "amount": grant.Amount}).Info("coins granted")
+
+	return api.PostApiCoinsGrant200Response{}, nil
+}
+
+// (PUT /api/catalog/{item}).
+func (s *MyService) PutApiCatalogItem(ctx context.Context, request api.PutApiCatalogItemRequestObject) (api.PutApiCatalogItemResponseObject, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return api.PutApiCatalogItem401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	price := request.Body.Price
+	if price <= 0 {
+		return api.PutApiCatalogItem400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("price must be positive"), http.StatusBadRequest)), nil
+	}
+
+	if err = s.db.PutItem(ctx, request.Item, price); err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"by": actor, "item": request.Item, "price": price}).Info("catalog item saved")
+
+	return api.PutApiCatalogItem200Response{}, nil
+}
+
+// (GET /api/reports/balances).
+func (s *MyService) GetApiReportsBalances(ctx context.Context, _ api.GetApiReportsBalancesRequestObject) (api.GetApiReportsBalancesResponseObject, error) {
+	balances, err := s.db.ListBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetApiReportsBalances200JSONResponse(toEmployeeBalances(balances)), nil
+}
+
+// (POST /api/admin/service-accounts).
+func (s *MyService) PostApiAdminServiceAccounts(ctx context.Context, request api.PostApiAdminServiceAccountsRequestObject) (api.PostApiAdminServiceAccountsResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiAdminServiceAccounts401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	accountRequest := *request.Body
+
+	if accountRequest.Name == "" {
+		return api.PostApiAdminServiceAccounts400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("name is required"), http.StatusBadRequest)), nil
+	}
+
+	account := db.ServiceAccount{Name: accountRequest.Name, CreatedBy: admin}
+	if accountRequest.Description != nil {
+		account.Description = *accountRequest.Description
+	}
+
+	if err = s.db.CreateServiceAccount(ctx, account); err != nil {
+		if errors.Is(err, db.ErrServiceAccountExists) {
+			return api.PostApiAdminServiceAccounts409ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusConflict)), nil
+		}
+
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"admin": admin, "account": account.Name}).Info("service account created")
+
+	return api.PostApiAdminServiceAccounts200Response{}, nil
+}
+
+// (GET /api/admin/service-accounts/{name}/keys).
+func (s *MyService) GetApiAdminServiceAccountsNameKeys(ctx context.Context, request api.GetApiAdminServiceAccountsNameKeysRequestObject) (api.GetApiAdminServiceAccountsNameKeysResponseObject, error) {
+	keys, err := s.db.ListAPIKeys(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetApiAdminServiceAccountsNameKeys200JSONResponse(toAPIKeys(keys)), nil
+}
+
+// (POST /api/admin/service-accounts/{name}/keys).
+func (s *MyService) PostApiAdminServiceAccountsNameKeys(ctx context.Context, request api.PostApiAdminServiceAccountsNameKeysRequestObject) (api.PostApiAdminServiceAccountsNameKeysResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiAdminServiceAccountsNameKeys401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	keyRequest := *request.Body
+
+	if len(keyRequest.Scopes) == 0 {
+		return api.PostApiAdminServiceAccountsNameKeys400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("at least one scope is required"), http.StatusBadRequest)), nil
+	}
+
+	scopes := make([]string, len(keyRequest.Scopes))
+	for i, scope := range keyRequest.Scopes {
+		if !auth.Scope(scope).Valid() {
+			return api.PostApiAdminServiceAccountsNameKeys400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("unknown scope %q", scope), http.StatusBadRequest)), nil
+		}
+
+		scopes[i] = string(scope)
+	}
+
+	if keyRequest.ExpiresAt != nil && !keyRequest.ExpiresAt.After(time.Now()) {
+		return api.PostApiAdminServiceAccountsNameKeys400ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("expiresAt must be in the future"), http.StatusBadRequest)), nil
+	}
+
+	key, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.CreateAPIKey(ctx, db.APIKey{
+		Prefix:    prefix,
+		Hash:      hash,
+		Account:   request.Name,
+		Scopes:    scopes,
+		ExpiresAt: keyRequest.ExpiresAt,
+		CreatedBy: admin,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrServiceAccountNotFound) {
+			return api.PostApiAdminServiceAccountsNameKeys404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
+
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"admin": admin, "account": request.Name, "prefix": prefix, "scopes": scopes}).Info("api key issued")
+
+	return api.PostApiAdminServiceAccountsNameKeys200JSONResponse{Key: key, Prefix: prefix, Scopes: scopes, ExpiresAt: keyRequest.ExpiresAt}, nil
+}
+
+// (DELETE /api/admin/service-accounts/{name}/keys/{prefix}).
+func (s *MyService) DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, request api.DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject) (api.DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.DeleteApiAdminServiceAccountsNameKeysPrefix401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	if err = s.db.RevokeAPIKey(ctx, request.Name, request.Prefix); err != nil {
+		if errors.Is(err, db.ErrAPIKeyNotFound) {
+			return api.DeleteApiAdminServiceAccountsNameKeysPrefix404ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusNotFound)), nil
+		}
+
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"admin": admin, "account": request.Name, "prefix": request.Prefix}).Info("api key revoked")
+
+	return api.DeleteApiAdminServiceAccountsNameKeysPrefix200Response{}, nil
+}
+
+// (GET /api/admin/lockouts).
+func (s *MyService) GetApiAdminLockouts(ctx context.Context, _ api.GetApiAdminLockoutsRequestObject) (api.GetApiAdminLockoutsResponseObject, error) {
+	events, err := s.db.ListLockouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetApiAdminLockouts200JSONResponse(toLockoutEvents(events)), nil
+}
+
+// (DELETE /api/admin/employees/{username}/lockout).
+func (s *MyService) DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, request api.DeleteApiAdminEmployeesUsernameLockoutRequestObject) (api.DeleteApiAdminEmployeesUsernameLockoutResponseObject, error) {
+	admin, err := loginFromContext(ctx)
+	if err != nil {
+		return api.DeleteApiAdminEmployeesUsernameLockout401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	if err = s.db.UnlockAuthKey(ctx, lockout.UsernameKey(request.Username), admin); err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(log.Fields{"admin": admin, "username": request.Username}).Info("login lockout lifted")
+
+	return api.DeleteApiAdminEmployeesUsernameLockout200Response{}, nil
+}
+
+// (GET /.well-known/jwks.json).
+func (s *MyService) GetWellKnownJwksJson(_ context.Context, _ api.GetWellKnownJwksJsonRequestObject) (api.GetWellKnownJwksJsonResponseObject, error) {
+	return api.GetWellKnownJwksJson200JSONResponse{
+		Body:    toJWKSet(auth.JWKS()),
+		Headers: api.GetWellKnownJwksJson200ResponseHeaders{CacheControl: "public, max-age=300"},
+	}, nil
+}
+
+// (GET /api/buy/{item}).
+func (s *MyService) GetApiBuyItem(ctx context.Context, request api.GetApiBuyItemRequestObject) (api.GetApiBuyItemResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.GetApiBuyItem401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	prepareOkResponse(ctx)
+
+	if err = s.db.BuyItem(ctx, username, request.Item); err != nil {
+		body, ok := domainProblem(ctx, err)
+
+		switch {
+		case ok && body.Status == http.StatusNotFound:
+			return api.GetApiBuyItem404ApplicationProblemPlusJSONResponse(body), nil
+		case ok && body.Status == http.StatusConflict:
+			return api.GetApiBuyItem409ApplicationProblemPlusJSONResponse(body), nil
+		}
+
+		return nil, err
+	}
+
+	return api.GetApiBuyItem200Response{}, nil
+}
+
+// (GET /api/info).
+func (s *MyService) GetApiInfo(ctx context.Context, _ api.GetApiInfoRequestObject) (api.GetApiInfoResponseObject, error) {
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.GetApiInfo401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	info, err := s.db.GetEmployeeInfo(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetApiInfo200JSONResponse(toInfoResponse(info)), nil
+}
+
+// (POST /api/sendCoin).
+func (s *MyService) PostApiSendCoin(ctx context.Context, request api.PostApiSendCoinRequestObject) (api.PostApiSendCoinResponseObject, error) {
+	sendCoinRequest := *request.Body
+
+	username, err := loginFromContext(ctx)
+	if err != nil {
+		return api.PostApiSendCoin401ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusUnauthorized)), nil
+	}
+
+	threshold := s.settings.Load().mfaTransferThreshold
+	if threshold > 0 && sendCoinRequest.Amount > threshold {
+		if sendCoinRequest.MfaCode == nil || *sendCoinRequest.MfaCode == "" {
+			metrics.FailedTransfers.WithLabelValues(metrics.ReasonMFA).Inc()
+
+			return api.PostApiSendCoin403ApplicationProblemPlusJSONResponse(newProblem(ctx, fmt.Errorf("a one-time code is required for transfers above %d coins", threshold), http.StatusForbidden)), nil
+		}
+
+		retryAfter, err := s.checkLockout(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+
+		if retryAfter > 0 {
+			return api.PostApiSendCoin429ApplicationProblemPlusJSONResponse{
+				Body:    newProblem(ctx, errLockedOut, http.StatusTooManyRequests),
+				Headers: api.PostApiSendCoin429ResponseHeaders{RetryAfter: retryAfter},
+			}, nil
+		}
+
+		if err = s.verifyMFACode(ctx, username, *sendCoinRequest.MfaCode); err != nil {
+			switch {
+			case errors.Is(err, db.ErrMFANotFound):
+				metrics.FailedTransfers.WithLabelValues(metrics.ReasonMFA).Inc()
+				err = fmt.Errorf("two-factor authentication must be enabled for transfers above %d coins", threshold)
+			case errors.Is(err, db.ErrMFACodeInvalid):
+				metrics.FailedTransfers.WithLabelValues(metrics.ReasonMFA).Inc()
+				s.recordAuthFailure(ctx, metrics.FactorMFA, username)
+			default:
+				return nil, err
+			}
+
+			return api.PostApiSendCoin403ApplicationProblemPlusJSONResponse(newProblem(ctx, err, http.StatusForbidden)), nil
+		}
+	}
+
+	prepareOkResponse(ctx)
+
+	err = s.db.TransferCoins(ctx, username, sendCoinRequest.ToUser, sendCoinRequest.Amount)
+	if err != nil {
+		body, ok := domainProblem(ctx, err)
+
+		switch {
+		case ok && body.Status == http.StatusBadRequest:
+			return api.PostApiSendCoin400ApplicationProblemPlusJSONResponse(body), nil
+		case ok && body.Status == http.StatusNotFound:
+			return api.PostApiSendCoin404ApplicationProblemPlusJSONResponse(body), nil
+		case ok && body.Status == http.StatusConflict:
+			return api.PostApiSendCoin409ApplicationProblemPlusJSONResponse(body), nil
+		case ok && body.Status == http.StatusUnprocessableEntity:
+			return api.PostApiSendCoin422ApplicationProblemPlusJSONResponse(body), nil
+		}
+
+		return nil, err
+	}
+
+	return api.PostApiSendCoin200Response{}, nil
+}
+
+// NewService creates the service. sso is nil when single sign-on is not configured.
+func NewService(db db.Repository, revoker Revoker, passwords *password.Policy, sso identity.RedirectProvider, cfg *config.Config) *MyService {
+	resetTTL := cfg.Auth.Password.ResetTTL
+	if resetTTL <= 0 {
+		resetTTL = defaultPasswordResetTTL
+	}
+
+	ssoLoginTTL := cfg.Auth.OIDC.LoginTTL
+	if ssoLoginTTL <= 0 {
+		ssoLoginTTL = defaultSSOLoginTTL
+	}
+
+	s := &MyService{
+		db:          db,
+		revoker:     revoker,
+		lockout:     lockout.New(db, cfg.Auth.Lockout.Username, cfg.Auth.Lockout.IP),
+		passwords:   passwords,
+		local:       identity.NewLocal(db),
+		sso:         sso,
+		resetTTL:    resetTTL,
+		ssoLoginTTL: ssoLoginTTL,
+	}
+	s.settings.Store(newSettings(cfg))
+
+	return s
+}
+
+// Reload applies the reloadable sections of cfg: registration, two-factor and lockout settings.
+func (s *MyService) Reload(cfg *config.Config) {
+	s.settings.Store(newSettings(cfg))
+	s.lockout.SetPolicies(cfg.Auth.Lockout.Username, cfg.Auth.Lockout.IP)
+}
+
+func newSettings(cfg *config.Config) *settings {
+	inviteTTL := cfg.Auth.Registration.InviteTTL
+	if inviteTTL <= 0 {
+		inviteTTL = defaultInviteTTL
+	}
+
+	mfaIssuer := cfg.Auth.MFA.Issuer
+	if mfaIssuer == "" {
+		mfaIssuer = defaultMFAIssuer
+	}
+
+	return &settings{
+		registrationMode:     cfg.Auth.Registration.Mode,
+		inviteTTL:            inviteTTL,
+		mfaIssuer:            mfaIssuer,
+		mfaTransferThreshold: cfg.Auth.MFA.TransferThreshold,
+	}
+}
+
+// Error codes of the problems the service answers with, on top of the generic ones of each status.
+const (
+	CodeInvalidAmount          = "invalid_amount"
+	CodeSelfTransfer           = "self_transfer"
+	CodeEmployeeNotFound       = "employee_not_found"
+	CodeItemNotFound           = "item_not_found"
+	CodeUnknownRecipient       = "unknown_recipient"
+	CodeInsufficientFunds      = "insufficient_funds"
+	CodeEmployeeExists         = "employee_exists"
+	CodeInvalidCredentials     = "invalid_credentials"
+	CodeInviteInvalid          = "invite_invalid"
+	CodePasswordResetInvalid   = "password_reset_invalid"
+	CodeSSOLoginInvalid        = "sso_login_invalid"
+	CodeIdentityNotLinked      = "identity_not_linked"
+	CodeIdentityConflict       = "identity_conflict"
+	CodeRefreshTokenInvalid    = "refresh_token_invalid"
+	CodeRefreshTokenReused     = "refresh_token_reused"
+	CodeMFANotEnabled          = "mfa_not_enabled"
+	CodeMFAEnabled             = "mfa_already_enabled"
+	CodeMFACodeInvalid         = "mfa_code_invalid"
+	CodeServiceAccountNotFound = "service_account_not_found"
+	CodeServiceAccountExists   = "service_account_exists"
+	CodeAPIKeyNotFound         = "api_key_not_found"
+	CodePasswordTooShort       = "password_too_short"
+	CodePasswordTooLong        = "password_too_long"
+	CodePasswordTooCommon      = "password_too_common"
+)
+
+// knownErrors gives the errors clients can tell apart a code and a title. The status is the one
+// domainProblem answers with; newProblem and writeErrResponse keep the status their caller picked.
+var knownErrors = []struct {
+	err    error
+	status int
+	kind   problem.Kind
+}{
+	{db.ErrInvalidAmount, http.StatusBadRequest, problem.Kind{Code: CodeInvalidAmount, English: "Transfer amount must be positive", Russian: "Сумма перевода должна быть положительной", Detail: "The amount must be a positive number of coins."}},
+	{db.ErrSelfTransfer, http.StatusBadRequest, problem.Kind{Code: CodeSelfTransfer, English: "Coins cannot be sent to yourself", Russian: "Нельзя отправить монеты самому себе", Detail: "The receiver must be another employee."}},
+	{db.ErrEmployeeNotFound, http.StatusNotFound, problem.Kind{Code: CodeEmployeeNotFound, English: "Employee not found", Russian: "Сотрудник не найден", Detail: "No employee has this username."}},
+	{db.ErrItemNotFound, http.StatusNotFound, problem.Kind{Code: CodeItemNotFound, English: "Item not found", Russian: "Товар не найден", Detail: "The shop has no item with this name."}},
+	{db.ErrUnknownRecipient, http.StatusUnprocessableEntity, problem.Kind{Code: CodeUnknownRecipient, English: "Recipient not found", Russian: "Получатель не найден", Detail: "No employee has the username of the receiver."}},
+	{db.ErrInsufficientFunds, http.StatusConflict, problem.Kind{Code: CodeInsufficientFunds, English: "Not enough coins", Russian: "Недостаточно монет", Detail: "The balance is lower than the price or the amount."}},
+	{db.ErrEmployeeExists, http.StatusConflict, problem.Kind{Code: CodeEmployeeExists, English: "Employee already exists", Russian: "Сотрудник уже существует", Detail: "An employee with this username already exists."}},
+	{identity.ErrInvalidCredentials, http.StatusUnauthorized, problem.Kind{Code: CodeInvalidCredentials, English: "Invalid username or password", Russian: "Неверное имя пользователя или пароль", Detail: "The username or the password is wrong."}},
+	{db.ErrInviteInvalid, http.StatusForbidden, problem.Kind{Code: CodeInviteInvalid, English: "Invite code is invalid", Russian: "Недействительный код приглашения", Detail: "The invite code is unknown, expired or already used."}},
+	{db.ErrPasswordResetInvalid, http.StatusBadRequest, problem.Kind{Code: CodePasswordResetInvalid, English: "Password reset token is invalid", Russian: "Недействительный токен сброса пароля", Detail: "The reset token is unknown, expired or already used."}},
+	{db.ErrOIDCLoginInvalid, http.StatusBadRequest, problem.Kind{Code: CodeSSOLoginInvalid, English: "Single sign-on attempt is invalid", Russian: "Недействительная попытка единого входа", Detail: "The sign-on attempt is unknown or expired; start it again."}},
+	{db.ErrIdentityNotLinked, http.StatusForbidden, problem.Kind{Code: CodeIdentityNotLinked, English: "External identity is not linked", Russian: "Внешняя учётная запись не привязана", Detail: "No employee is linked to the external identity."}},
+	{db.ErrIdentityConflict, http.StatusForbidden, problem.Kind{Code: CodeIdentityConflict, English: "Employee is linked to another identity", Russian: "Сотрудник привязан к другой учётной записи", Detail: "The employee is already linked to another external identity."}},
+	{db.ErrRefreshTokenNotFound, http.StatusUnauthorized, problem.Kind{Code: CodeRefreshTokenInvalid, English: "Refresh token is invalid", Russian: "Недействительный refresh-токен", Detail: "The refresh token is unknown, expired or revoked; sign in again."}},
+	{db.ErrRefreshTokenExpired, http.StatusUnauthorized, problem.Kind{Code: CodeRefreshTokenInvalid, English: "Refresh token is invalid", Russian: "Недействительный refresh-токен", Detail: "The refresh token is unknown, expired or revoked; sign in again."}},
+	{db.ErrRefreshTokenRevoked, http.StatusUnauthorized, problem.Kind{Code: CodeRefreshTokenInvalid, English: "Refresh token is invalid", Russian: "Недействительный refresh-токен", Detail: "The refresh token is unknown, expired or revoked; sign in again."}},
+	{db.ErrRefreshTokenReused, http.StatusUnauthorized, problem.Kind{Code: CodeRefreshTokenReused, English: "Refresh token was already used", Russian: "Refresh-токен уже использован", Detail: "The refresh token was already used, so its sessions are revoked; sign in again."}},
+	{db.ErrMFANotFound, http.StatusBadRequest, problem.Kind{Code: CodeMFANotEnabled, English: "Two-factor authentication is not enabled", Russian: "Двухфакторная аутентификация не включена", Detail: "Two-factor authentication is not enabled for the employee."}},
+	{db.ErrMFAEnabled, http.StatusConflict, problem.Kind{Code: CodeMFAEnabled, English: "Two-factor authentication is already enabled", Russian: "Двухфакторная аутентификация уже включена", Detail: "Two-factor authentication is already enabled for the employee."}},
+	{db.ErrMFACodeInvalid, http.StatusUnauthorized, problem.Kind{Code: CodeMFACodeInvalid, English: "One-time code is invalid", Russian: "Неверный одноразовый код", Detail: "The one-time code is wrong or was already used."}},
+	{db.ErrServiceAccountNotFound, http.StatusNotFound, problem.Kind{Code: CodeServiceAccountNotFound, English: "Service account not found", Russian: "Сервисный аккаунт не найден", Detail: "No service account has this name."}},
+	{db.ErrServiceAccountExists, http.StatusConflict, problem.Kind{Code: CodeServiceAccountExists, English: "Service account already exists", Russian: "Сервисный аккаунт уже существует", Detail: "A service account with this name already exists."}},
+	{db.ErrAPIKeyNotFound, http.StatusNotFound, problem.Kind{Code: CodeAPIKeyNotFound, English: "API key not found", Russian: "API-ключ не найден", Detail: "The service account has no key with this prefix."}},
+	{password.ErrTooShort, http.StatusBadRequest, problem.Kind{Code: CodePasswordTooShort, English: "Password is too short", Russian: "Пароль слишком короткий", Detail: "The password is shorter than the password policy allows."}},
+	{password.ErrTooLong, http.StatusBadRequest, problem.Kind{Code: CodePasswordTooLong, English: "Password is too long", Russian: "Пароль слишком длинный", Detail: "The password is longer than 72 bytes, the limit of bcrypt."}},
+	{password.ErrCommon, http.StatusBadRequest, problem.Kind{Code: CodePasswordTooCommon, English: "Password is too common", Russian: "Пароль слишком распространён", Detail: "The password is on the list of common passwords."}},
+}
+
+// issueTokens issues an access token and starts a new refresh token family for the employee.
+func (s *MyService) issueTokens(ctx context.Context, username string) (api.AuthResponse, error) {
+	token, err := s.createToken(ctx, username)
+	if err != nil {
+		return api.AuthResponse{}, err
+	}
+
+	refreshToken, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return api.AuthResponse{}, err
+	}
+
+	err = s.db.CreateRefreshToken(ctx, db.RefreshToken{
+		Hash:      hash,
+		Username:  username,
+		ExpiresAt: time.Now().Add(auth.RefreshTokenTTL),
+	})
+	if err != nil {
+		return api.AuthResponse{}, err
+	}
+
+	return api.AuthResponse{Token: &token, RefreshToken: &refreshToken}, nil
+}
+
+// checkLockout returns the seconds until the username and the client IP may try again,
+// 0 while neither is blocked.
+func (s *MyService) checkLockout(ctx context.Context, username string) (int, error) {
+	retryAfter, err := s.lockout.Check(ctx, username, clientIP(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(math.Ceil(retryAfter.Seconds())), nil
+}
+
+// firstFactorLogin finishes a login after the password or single sign-on verified the employee. Employees with two-factor
+// authentication get a short-lived mfa token for POST /api/auth/mfa instead of a session.
+func (s *MyService) firstFactorLogin(ctx context.Context, username string) (api.AuthResponse, error) {
+	enrollment, err := s.db.GetMFA(ctx, username)
+	if err != nil && !errors.Is(err, db.ErrMFANotFound) {
+		return api.AuthResponse{}, err
+	}
+
+	if err == nil && enrollment.Confirmed {
+		mfaToken, err := auth.CreateMFAToken(username)
+		if err != nil {
+			return api.AuthResponse{}, err
+		}
+
+		mfaRequired := true
+
+		return api.AuthResponse{MfaRequired: &mfaRequired, MfaToken: &mfaToken}, nil
+	}
+
+	// failures are only forgotten after the last factor, otherwise a known password
+	// would reset the counter that protects the one-time codes
+	if err = s.lockout.Succeed(ctx, username); err != nil {
+		logger.Warn(err)
+	}
+
+	return s.issueTokens(ctx, username)
+}
+
+// verifyMFACode accepts a current TOTP code or an unused recovery code of an employee
+// with confirmed two-factor authentication. Every code is accepted only once.
+func (s *MyService) verifyMFACode(ctx context.Context, username, code string) error {
+	enrollment, err := s.db.GetMFA(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if !enrollment.Confirmed {
+		return db.ErrMFANotFound
+	}
+
+	if step, ok := mfa.Verify(enrollment.Secret, code, time.Now()); ok {
+		return s.db.UseMFAStep(ctx, username, step)
+	}
+
+	return s.db.UseRecoveryCode(ctx, username, auth.HashOpaqueToken(mfa.NormalizeRecoveryCode(code)))
+}
+
+// recordAuthFailure feeds the brute-force protection. A storage error here
+// must not turn a wrong password into a 500, so it is only logged.
+func (s *MyService) recordAuthFailure(ctx context.Context, factor, username string) {
+	metrics.AuthFailures.WithLabelValues(factor).Inc()
+
+	if err := s.lockout.Fail(ctx, username, clientIP(ctx)); err != nil {
+		logger.Warn(err)
+	}
+}
+
+// createToken issues an access token carrying the employee's current role.
+func (s *MyService) createToken(ctx context.Context, username string) (string, error) {
+	role, err := s.db.GetEmployeeRole(ctx, username)
+	if err != nil {
+		return "", err
+	}
+
+	return auth.CreateToken(username, auth.Role(role))
+}
+
+// loginFromContext is the employee middleware.Authentication authenticated the request as.
+func loginFromContext(ctx context.Context) (string, error) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Username, nil
+}
+
+// actorFromContext names who made the request, for routes that service accounts can call too.
+func actorFromContext(ctx context.Context) (string, error) {
+	if account, ok := auth.ServiceAccountFromContext(ctx); ok {
+		return "service-account:" + account.Name, nil
+	}
+
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.Username, nil
+	}
+
+	return "", errNotAuthenticated
+}
+
+// claimsFromContext returns the claims of the access token middleware.Authentication verified.
+func claimsFromContext(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, errNotAuthenticated
+	}
+
+	return claims, nil
+}
+
+// prepareOkResponse hands the success response, a 200 without a body, to the ledger
+// transaction, which stores it under the idempotency key of the request, if it has one.
+func prepareOkResponse(ctx context.Context) {
+	idempotency.Prepare(ctx, http.StatusOK, "", nil)
+}
+
+// NewHandler serves s on the routes of the spec. The strict handler decodes the requests into
+// the typed request objects; a body it cannot decode is answered with 400, and an error a
+// handler returns instead of a response with 500.
+func NewHandler(s *MyService) api.ServerInterface {
+	return optionalBodies{api.NewStrictHandlerWithOptions(s, []api.StrictMiddlewareFunc{keepExchange}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeErrResponse(w, r, err, http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeErrResponse(w, r, err, http.StatusInternalServerError)
+		},
+	})}
+}
+
+// optionalBodies lets the bodies the spec marks as optional be left out: the strict handler
+// decodes the body of every operation and would answer an empty one with 400.
+type optionalBodies struct {
+	api.ServerInterface
+}
+
+// (POST /api/auth/logout).
+func (h optionalBodies) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+	// ContentLength is -1 for a chunked body, which may turn out empty as well
+	body := bufio.NewReader(r.Body)
+	if _, err := body.Peek(1); errors.Is(err, io.EOF) {
+		r.Body = io.NopCloser(strings.NewReader("{}"))
+	} else {
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{body, r.Body}
+	}
+
+	h.ServerInterface.PostApiAuthLogout(w, r)
+}
+
+type exchangeKey struct{}
+
+type exchange struct {
+	w http.ResponseWriter
+	r *http.Request
+}
+
+// keepExchange gives the handlers what the typed request objects leave out: the credentials,
+// the client IP and the TLS state of the request, and the response headers of problems.
+func keepExchange(f api.StrictHandlerFunc, _ string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		return f(context.WithValue(ctx, exchangeKey{}, exchange{w: w, r: r}), w, r, request)
+	}
+}
+
+func httpRequest(ctx context.Context) *http.Request {
+	return ctx.Value(exchangeKey{}).(exchange).r
+}
+
+func clientIP(ctx context.Context) string {
+	return middleware.ClientIP(httpRequest(ctx))
+}
+
+// newProblem is the body of a documented error response: the problem writeErrResponse would
+// answer with for err and statusCode.
+func newProblem(ctx context.Context, err error, statusCode int) api.ErrorResponse {
+	return problemBody(ctx, err, statusCode, problemKind(err, statusCode))
+}
+
+// domainProblem is the body of the error response for a known error, with the status of the
+// error. ok is false for any other error, which the handlers answer with 500.
+func domainProblem(ctx context.Context, err error) (body api.ErrorResponse, ok bool) {
+	for _, e := range knownErrors {
+		if errors.Is(err, e.err) {
+			return problemBody(ctx, err, e.status, e.kind), true
+		}
+	}
+
+	return api.ErrorResponse{}, false
+}
+
+// problemKind is the kind of a known error or else the generic one of the status.
+func problemKind(err error, statusCode int) problem.Kind {
+	for _, e := range knownErrors {
+		if errors.Is(err, e.err) {
+			return e.kind
+		}
+	}
+
+	return problem.ForStatus(statusCode)
+}
+
+// problemBody logs err; the client gets the fixed detail of the kind instead of the cause.
+func problemBody(ctx context.Context, err error, statusCode int, kind problem.Kind) api.ErrorResponse {
+	ex := ctx.Value(exchangeKey{}).(exchange)
+
+	logger.WithContext(ctx).Warn(err)
+
+	details := problem.New(ex.r, statusCode, kind, "")
+	if statusCode >= http.StatusInternalServerError {
+		details.RequestID = middleware.RequestIDFromContext(ex.r.Context())
+	}
+	ex.w.Header().Set("Content-Language", problem.Language(ex.r).String())
+
+	return api.ErrorResponse{
+		Type:      details.Type,
+		Title:     details.Title,
+		Status:    details.Status,
+		Detail:    &details.Detail,
+		Instance:  &details.Instance,
+		Code:      details.Code,
+		RequestId: optional(details.RequestID),
+	}
+}
+
+// writeErrResponse answers with a problem with the request. The code is the one of a known
+// error or else the generic one of the status. Errors are logged, but their cause never
+// reaches the client: the detail is the fixed one of the kind.
+func writeErrResponse(w http.ResponseWriter, r *http.Request, err error, statusCode int) {
+	details := problem.New(r, statusCode, problemKind(err, statusCode), "")
+
+	if statusCode >= http.StatusInternalServerError {
+		logger.WithContext(r.Context()).Error(err)
+		// the same request ID middleware.Recoverer answers with, to quote in a bug report
+		details.RequestID = middleware.RequestIDFromContext(r.Context())
+	} else {
+		logger.WithContext(r.Context()).Warn(err)
+	}
+
+	problem.Write(w, r, details)
 }
@@ -2,40 +2,108 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/basedalex/merch-shop/internal/apikey"
 	"github.com/basedalex/merch-shop/internal/auth"
+	"github.com/basedalex/merch-shop/internal/config"
+	"github.com/basedalex/merch-shop/internal/db"
+	"github.com/basedalex/merch-shop/internal/denylist"
+	"github.com/basedalex/merch-shop/internal/identity"
+	"github.com/basedalex/merch-shop/internal/mfa"
+	"github.com/basedalex/merch-shop/internal/middleware"
 	"github.com/basedalex/merch-shop/internal/mocks"
+	"github.com/basedalex/merch-shop/internal/password"
+	"github.com/basedalex/merch-shop/internal/problem"
 	api "github.com/basedalex/merch-shop/internal/swagger"
+	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// testPasswords hashes with the cheapest bcrypt cost and has no minimum length.
+var testPasswords *password.Policy
+
+func TestMain(m *testing.M) {
+	cfg := &config.Config{}
+	cfg.Auth.SigningKeyID = "test"
+	cfg.Auth.Keys = []config.SigningKey{{ID: "test", Algorithm: auth.AlgHS256, Secret: "test-secret"}}
+
+	if err := auth.Init(cfg); err != nil {
+		panic(err)
+	}
+
+	var err error
+
+	testPasswords, err = password.NewPolicy(config.PasswordPolicy{BcryptCost: bcrypt.MinCost})
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func testConfig(registrationMode string) *config.Config {
+	cfg := &config.Config{}
+	cfg.Auth.Registration.Mode = registrationMode
+
+	return cfg
+}
+
+// authenticate runs req through middleware.Authentication, which puts the claims of its token
+// in the context the handlers read them from, as the router does.
+func authenticate(t *testing.T, req *http.Request) *http.Request {
+	t.Helper()
+
+	var authenticated *http.Request
+	middleware.Authentication(denylist.New(nil), nil)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authenticated = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	require.NotNil(t, authenticated, "the token is accepted")
+
+	return authenticated
+}
+
 func TestPostApiAuth(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockDB := mocks.NewMockRepository(ctrl)
-	s := &MyService{db: mockDB}
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationImplicit))
 
 	t.Run("Authenticate success, return token", func(t *testing.T) {
 		authReq := api.AuthRequest{Username: "testuser", Password: "password"}
 		requestBody, _ := json.Marshal(authReq)
 		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, nil)
-		token, _ := auth.CreateToken(authReq.Username)
+		mockDB.EXPECT().GetMFA(gomock.Any(), authReq.Username).Return(nil, db.ErrMFANotFound)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), authReq.Username).Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
 		w := httptest.NewRecorder()
 
-		s.PostApiAuth(w, req)
+		NewHandler(s).PostApiAuth(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		assert.Equal(t, token, w.Body.String())
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		username, err := auth.ExtractUsername(*resp.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, authReq.Username, username)
+		assert.NotEmpty(t, *resp.RefreshToken)
 	})
 
 	t.Run("Authenticate fail, create user and return token", func(t *testing.T) {
@@ -43,31 +111,209 @@ func TestPostApiAuth(t *testing.T) {
 		requestBody, _ := json.Marshal(authReq)
 		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(false, nil)
 		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Return(nil)
-		token, _ := auth.CreateToken(authReq.Username)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), authReq.Username).Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
 		w := httptest.NewRecorder()
 
-		s.PostApiAuth(w, req)
+		NewHandler(s).PostApiAuth(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		assert.Equal(t, token, w.Body.String())
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		username, err := auth.ExtractUsername(*resp.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, authReq.Username, username)
+		assert.NotEmpty(t, *resp.RefreshToken)
+	})
+
+	t.Run("Unknown employee outside implicit mode", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+		authReq := api.AuthRequest{Username: "typo", Password: "password"}
+		requestBody, _ := json.Marshal(authReq)
+		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(false, nil)
+		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Times(0)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		wrongPassword := api.AuthRequest{Username: "alice", Password: "password"}
+		requestBody, _ = json.Marshal(wrongPassword)
+		mockDB.EXPECT().Authenticate(gomock.Any(), wrongPassword).Return(true, bcrypt.ErrMismatchedHashAndPassword)
+
+		req = httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		wrong := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(wrong, req)
+
+		assert.Equal(t, wrong.Code, w.Code)
+		assert.JSONEq(t, wrong.Body.String(), w.Body.String(), "an unknown username is answered like a wrong password")
 	})
 
 	t.Run("Authentication error", func(t *testing.T) {
 		authReq := api.AuthRequest{Username: "user", Password: "wrongpass"}
 		requestBody, _ := json.Marshal(authReq)
 
-		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, errors.New("error: credentials are incorrect"))
+		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, bcrypt.ErrMismatchedHashAndPassword)
 		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Times(0)
 
 		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
 		w := httptest.NewRecorder()
 
-		s.PostApiAuth(w, req)
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), CodeInvalidCredentials)
+		assert.NotContains(t, w.Body.String(), "bcrypt", "the cause is only logged")
+	})
+}
+
+func TestPostApiRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+
+	register := func(s *MyService, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/register", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiRegister(w, req)
+
+		return w
+	}
+
+	t.Run("Open registration", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Return(nil)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "newuser").Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Username taken", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Return(db.ErrEmployeeExists)
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Invite required", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationInvite))
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Invite redeemed", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationInvite))
+
+		mockDB.EXPECT().CreateEmployeeWithInvite(gomock.Any(), gomock.Any(), auth.HashOpaqueToken("code")).Return(nil)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "newuser").Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery","inviteCode":"code"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Common password", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Times(0)
+
+		w := register(s, `{"username":"newuser","password":"qwerty123"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), CodePasswordTooCommon)
+	})
+
+	t.Run("Admin-only provisioning", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationAdmin))
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Mode reloaded", func(t *testing.T) {
+		s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+		s.Reload(testConfig(config.RegistrationAdmin))
+
+		mockDB.EXPECT().CreateEmployee(gomock.Any(), gomock.Any()).Times(0)
+
+		w := register(s, `{"username":"newuser","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestPostApiAuthRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+	t.Run("Refresh success, rotate token", func(t *testing.T) {
+		refreshToken := "old-refresh-token"
+		requestBody, _ := json.Marshal(api.RefreshRequest{RefreshToken: refreshToken})
+
+		mockDB.EXPECT().RotateRefreshToken(gomock.Any(), auth.HashOpaqueToken(refreshToken), gomock.Any()).Return("testuser", nil)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "testuser").Return("shop-manager", nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/refresh", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuthRefresh(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+		claims, err := auth.ParseToken(*resp.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, "testuser", claims.Username)
+		assert.Equal(t, auth.RoleShopManager, claims.Role)
+		assert.NotEqual(t, refreshToken, *resp.RefreshToken)
+	})
+
+	t.Run("Reused token is rejected", func(t *testing.T) {
+		requestBody, _ := json.Marshal(api.RefreshRequest{RefreshToken: "stolen"})
+
+		mockDB.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).Return("", db.ErrRefreshTokenReused)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/refresh", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuthRefresh(w, req)
 
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
-		assert.Contains(t, w.Body.String(), "error: credentials are incorrect")
+	})
+
+	t.Run("Missing token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/refresh", bytes.NewBufferString(`{}`))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuthRefresh(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
 
@@ -76,13 +322,13 @@ func TestGetApiBuyItem(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockDB := mocks.NewMockRepository(ctrl)
-	s := NewService(mockDB)
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
 
 	t.Run("Buy success", func(t *testing.T) {
 		username := "test"
 		item := "book"
 
-		token, err := auth.CreateToken(username)
+		token, err := auth.CreateToken(username, auth.RoleEmployee)
 		assert.NoError(t, err)
 
 		mockDB.EXPECT().BuyItem(gomock.Any(), username, item).Return(nil)
@@ -92,10 +338,1064 @@ func TestGetApiBuyItem(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		s.GetApiBuyItem(w, req, item)
+		NewHandler(s).GetApiBuyItem(w, authenticate(t, req), item)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String(), "the spec documents the 200 without a body")
+	})
+
+	token, err := auth.CreateToken("test", auth.RoleEmployee)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"Unknown item", fmt.Errorf("%w: hat", db.ErrItemNotFound), http.StatusNotFound, CodeItemNotFound},
+		{"Not enough coins", db.ErrInsufficientFunds, http.StatusConflict, CodeInsufficientFunds},
+		{"Database failure", errors.New("connection refused"), http.StatusInternalServerError, problem.Internal.Code},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB.EXPECT().BuyItem(gomock.Any(), "test", "hat").Return(tc.err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/buy/hat", nil)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			req.Header.Set(middleware.RequestIDHeader, "req-buy")
+			w := httptest.NewRecorder()
+
+			middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				NewHandler(s).GetApiBuyItem(w, authenticate(t, r), "hat")
+			})).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+
+			var resp problem.Details
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tc.code, resp.Code)
+			assert.Equal(t, tc.status, resp.Status)
+			assert.Equal(t, "/api/buy/hat", resp.Instance)
+			assert.NotContains(t, w.Body.String(), "connection refused", "internal causes are not returned")
+
+			if tc.status >= http.StatusInternalServerError {
+				assert.Equal(t, "req-buy", resp.RequestID, "server errors carry the request ID, as in middleware.Recoverer")
+			} else {
+				assert.Empty(t, resp.RequestID)
+			}
+		})
+	}
+}
+
+func TestPostApiSendCoin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+	token, err := auth.CreateToken("alice", auth.RoleEmployee)
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"Invalid amount", fmt.Errorf("%w: -5", db.ErrInvalidAmount), http.StatusBadRequest, CodeInvalidAmount},
+		{"Self transfer", db.ErrSelfTransfer, http.StatusBadRequest, CodeSelfTransfer},
+		{"Unknown recipient", fmt.Errorf("%w: bob", db.ErrUnknownRecipient), http.StatusUnprocessableEntity, CodeUnknownRecipient},
+		{"Not enough coins", db.ErrInsufficientFunds, http.StatusConflict, CodeInsufficientFunds},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB.EXPECT().TransferCoins(gomock.Any(), "alice", "bob", 5).Return(tc.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewBufferString(`{"toUser":"bob","amount":5}`))
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			w := httptest.NewRecorder()
+
+			NewHandler(s).PostApiSendCoin(w, authenticate(t, req))
+
+			assert.Equal(t, tc.status, w.Code)
+
+			var resp problem.Details
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tc.code, resp.Code)
+			assert.Equal(t, problem.TypePrefix+tc.code, resp.Type)
+			assert.Equal(t, problemKind(tc.err, tc.status).Detail, resp.Detail, "a fixed detail instead of the cause")
+		})
+	}
+
+	t.Run("Title in the language of the client", func(t *testing.T) {
+		mockDB.EXPECT().TransferCoins(gomock.Any(), "alice", "bob", 5).Return(db.ErrInsufficientFunds)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/sendCoin", bytes.NewBufferString(`{"toUser":"bob","amount":5}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Set("Accept-Language", "ru-RU,ru;q=0.9,en;q=0.8")
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiSendCoin(w, authenticate(t, req))
+
+		var resp problem.Details
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "Недостаточно монет", resp.Title)
+		assert.Equal(t, "ru", w.Header().Get("Content-Language"))
+	})
+}
+
+func TestPostApiAuthLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	s := NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen))
+
+	t.Run("Logout revokes token and refresh family", func(t *testing.T) {
+		token, err := auth.CreateToken("test", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		claims, err := auth.ParseToken(token)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeToken(gomock.Any(), gomock.Any(), "test").Return(nil)
+		mockDB.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), auth.HashOpaqueToken("refresh"), "test").Return(nil)
+
+		requestBody, _ := json.Marshal(map[string]string{"refreshToken": "refresh"})
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", bytes.NewBuffer(requestBody))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuthLogout(w, authenticate(t, req))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, revoked.IsRevoked(claims))
+	})
+
+	t.Run("Logout without a body", func(t *testing.T) {
+		token, err := auth.CreateToken("test", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeToken(gomock.Any(), gomock.Any(), "test").Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuthLogout(w, authenticate(t, req))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Logout with an empty chunked body", func(t *testing.T) {
+		token, err := auth.CreateToken("test", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeToken(gomock.Any(), gomock.Any(), "test").Return(nil)
+
+		// a reader of unknown length leaves ContentLength at -1, as Transfer-Encoding: chunked does
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", struct{ io.Reader }{strings.NewReader("")})
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		require.Equal(t, int64(-1), req.ContentLength)
+		NewHandler(s).PostApiAuthLogout(w, authenticate(t, req))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestGetApiInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, testConfig(config.RegistrationOpen))
+
+	token, err := auth.CreateToken("test", auth.RoleEmployee)
+	assert.NoError(t, err)
+
+	mockDB.EXPECT().GetEmployeeInfo(gomock.Any(), "test").Return(&db.InfoResponse{
+		Coins:       900,
+		Inventory:   []db.Item{{Type: "cup", Quantity: 1}},
+		CoinHistory: db.CoinHistory{Sent: []db.Transaction{{ToUser: "bob", Amount: 80}}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	w := httptest.NewRecorder()
+
+	NewHandler(s).GetApiInfo(w, authenticate(t, req))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"coins": 900,
+		"inventory": [{"type": "cup", "quantity": 1}],
+		"coinHistory": {"received": [], "sent": [{"toUser": "bob", "amount": 80}]}
+	}`, w.Body.String())
+
+	t.Run("Scheme in lower case", func(t *testing.T) {
+		revoked := denylist.New(mockDB)
+		mockDB.EXPECT().GetEmployeeInfo(gomock.Any(), "test").Return(&db.InfoResponse{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("bearer %s", token))
+		w := httptest.NewRecorder()
+
+		newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "the handler accepts what middleware.Authentication does")
+	})
+}
+
+// newRouter wires the service the same way main does, so authorization is checked too.
+func newRouter(s *MyService, revoked *denylist.Denylist) http.Handler {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		panic(err)
+	}
+
+	r := chi.NewRouter()
+	r.Use(middleware.Authentication(revoked, apikey.NewVerifier(s.db)))
+
+	return api.HandlerWithOptions(NewHandler(s), api.ChiServerOptions{
+		BaseRouter: r,
+		Middlewares: []api.MiddlewareFunc{
+			middleware.Validation(spec, true),
+			middleware.Authorize(AccessPolicy, ScopePolicy),
+		},
+	})
+}
+
+func TestDeleteApiAdminEmployeesUsernameSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	t.Run("Not an admin", func(t *testing.T) {
+		token, err := auth.CreateToken("test", auth.RoleShopManager)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeAllSessions(gomock.Any(), gomock.Any()).Times(0)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/admin/employees/victim/sessions", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Admin revokes all sessions", func(t *testing.T) {
+		victimToken, err := auth.CreateToken("victim", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		victimClaims, err := auth.ParseToken(victimToken)
+		assert.NoError(t, err)
+
+		token, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeAllSessions(gomock.Any(), "victim").Return(time.Now(), nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/admin/employees/victim/sessions", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, revoked.IsRevoked(victimClaims))
+	})
+
+	t.Run("Unknown employee", func(t *testing.T) {
+		token, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().RevokeAllSessions(gomock.Any(), "ghost").Return(time.Time{}, db.ErrEmployeeNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/admin/employees/ghost/sessions", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPutApiAdminEmployeesUsernameRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	adminToken, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+	assert.NoError(t, err)
+
+	t.Run("Finance admin changes role", func(t *testing.T) {
+		mockDB.EXPECT().SetEmployeeRole(gomock.Any(), "bob", "shop-manager").Return(nil)
+		mockDB.EXPECT().RevokeAllSessions(gomock.Any(), "bob").Return(time.Now(), nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/employees/bob/role", bytes.NewBufferString(`{"role":"shop-manager"}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		assert.Contains(t, w.Body.String(), "")
 	})
 
+	t.Run("Unknown role", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/employees/bob/role", bytes.NewBufferString(`{"role":"ceo"}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Demoted admin loses access", func(t *testing.T) {
+		carolToken, err := auth.CreateToken("carol", auth.RoleFinanceAdmin)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().SetEmployeeRole(gomock.Any(), "carol", "employee").Return(nil)
+		mockDB.EXPECT().RevokeAllSessions(gomock.Any(), "carol").Return(time.Now(), nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/employees/carol/role", bytes.NewBufferString(`{"role":"employee"}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		mockDB.EXPECT().SetEmployeeRole(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		req = httptest.NewRequest(http.MethodPut, "/api/admin/employees/bob/role", bytes.NewBufferString(`{"role":"finance-admin"}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", carolToken))
+		w = httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "the token with the old role is revoked")
+	})
+
+	t.Run("Employee cannot change roles", func(t *testing.T) {
+		token, err := auth.CreateToken("bob", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/employees/bob/role", bytes.NewBufferString(`{"role":"finance-admin"}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestPostApiAuthLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+
+	cfg := testConfig(config.RegistrationOpen)
+	cfg.Auth.Lockout.Username = config.LockoutPolicy{FreeAttempts: 3, BaseDelay: time.Second, LockoutThreshold: 10, LockoutDuration: time.Hour}
+	s := NewService(mockDB, denylist.New(mockDB), testPasswords, nil, cfg)
+
+	authReq := api.AuthRequest{Username: "victim", Password: "guess"}
+	requestBody, _ := json.Marshal(authReq)
+
+	t.Run("Blocked attempt skips the password check", func(t *testing.T) {
+		mockDB.EXPECT().GetBlockedUntil(gomock.Any(), []string{"username:victim", "ip:192.0.2.1"}).Return(time.Now().Add(90*time.Second), nil)
+		mockDB.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Times(0)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "90", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("Wrong password is counted", func(t *testing.T) {
+		mockDB.EXPECT().GetBlockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, errors.New("error: credentials are incorrect"))
+		mockDB.EXPECT().RecordAuthFailure(gomock.Any(), "username:victim", gomock.Any()).Return(4, nil)
+		mockDB.EXPECT().BlockAuthKey(gomock.Any(), "username:victim", gomock.Any()).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Threshold locks the account", func(t *testing.T) {
+		mockDB.EXPECT().GetBlockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, errors.New("error: credentials are incorrect"))
+		mockDB.EXPECT().RecordAuthFailure(gomock.Any(), "username:victim", gomock.Any()).Return(10, nil)
+		mockDB.EXPECT().LockAuthKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, event db.LockoutEvent) error {
+			assert.Equal(t, "username:victim", event.Key)
+			assert.Equal(t, 10, event.Failures)
+
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Successful login resets the counter", func(t *testing.T) {
+		mockDB.EXPECT().GetBlockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockDB.EXPECT().Authenticate(gomock.Any(), authReq).Return(true, nil)
+		mockDB.EXPECT().GetMFA(gomock.Any(), "victim").Return(nil, db.ErrMFANotFound)
+		mockDB.EXPECT().ResetAuthFailures(gomock.Any(), "username:victim").Return(nil)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "victim").Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/auth", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		NewHandler(s).PostApiAuth(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestAdminLockouts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	adminToken, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+	assert.NoError(t, err)
+
+	t.Run("List active lockouts", func(t *testing.T) {
+		mockDB.EXPECT().ListLockouts(gomock.Any()).Return([]db.LockoutEvent{{ID: 1, Key: "username:victim", Failures: 10}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/lockouts", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var events []db.LockoutEvent
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &events))
+		assert.Len(t, events, 1)
+		assert.Equal(t, "username:victim", events[0].Key)
+	})
+
+	t.Run("Unlock employee", func(t *testing.T) {
+		mockDB.EXPECT().UnlockAuthKey(gomock.Any(), "username:victim", "admin").Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/admin/employees/victim/lockout", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Employee cannot unlock", func(t *testing.T) {
+		token, err := auth.CreateToken("victim", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().UnlockAuthKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/admin/employees/victim/lockout", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestPostApiPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	changePassword := func(token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/password", bytes.NewBufferString(body))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	t.Run("Wrong current password", func(t *testing.T) {
+		token, err := auth.CreateToken("alice", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().Authenticate(gomock.Any(), api.AuthRequest{Username: "alice", Password: "wrong"}).
+			Return(true, errors.New("error: credentials are incorrect"))
+		mockDB.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		w := changePassword(token, `{"currentPassword":"wrong","newPassword":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("New password rejected by policy", func(t *testing.T) {
+		token, err := auth.CreateToken("alice", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockDB.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		w := changePassword(token, `{"currentPassword":"old-password","newPassword":"iloveyou"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Password changed, sessions revoked", func(t *testing.T) {
+		token, err := auth.CreateToken("alice", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		claims, err := auth.ParseToken(token)
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().Authenticate(gomock.Any(), api.AuthRequest{Username: "alice", Password: "old-password"}).Return(true, nil)
+		mockDB.EXPECT().ChangePassword(gomock.Any(), "alice", gomock.Any()).DoAndReturn(func(_ interface{}, _, hash string) (time.Time, error) {
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct-horse-battery")))
+
+			return time.Now(), nil
+		})
+
+		w := changePassword(token, `{"currentPassword":"old-password","newPassword":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, revoked.IsRevoked(claims))
+	})
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	adminToken, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+	assert.NoError(t, err)
+
+	t.Run("Admin issues reset token", func(t *testing.T) {
+		var stored db.PasswordReset
+
+		mockDB.EXPECT().CreatePasswordReset(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, reset db.PasswordReset) error {
+			stored = reset
+
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/employees/bob/password-reset", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.PasswordResetResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, auth.HashOpaqueToken(resp.ResetToken), stored.Hash)
+		assert.Equal(t, "bob", stored.Username)
+		assert.Equal(t, "admin", stored.CreatedBy)
+	})
+
+	t.Run("Unknown employee", func(t *testing.T) {
+		mockDB.EXPECT().CreatePasswordReset(gomock.Any(), gomock.Any()).Return(db.ErrEmployeeNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/employees/ghost/password-reset", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Employee cannot issue reset tokens", func(t *testing.T) {
+		token, err := auth.CreateToken("bob", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/employees/alice/password-reset", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Reset without access token", func(t *testing.T) {
+		mockDB.EXPECT().ResetPassword(gomock.Any(), auth.HashOpaqueToken("reset"), gomock.Any()).Return("bob", time.Now(), nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/password/reset", bytes.NewBufferString(`{"resetToken":"reset","newPassword":"correct-horse-battery"}`))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Used reset token", func(t *testing.T) {
+		mockDB.EXPECT().ResetPassword(gomock.Any(), gomock.Any(), gomock.Any()).Return("", time.Time{}, db.ErrPasswordResetInvalid)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/password/reset", bytes.NewBufferString(`{"resetToken":"reset","newPassword":"correct-horse-battery"}`))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+
+	cfg := testConfig(config.RegistrationOpen)
+	cfg.Auth.MFA.TransferThreshold = 100
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, cfg), revoked)
+
+	secret, err := mfa.NewSecret()
+	assert.NoError(t, err)
+
+	enrolled := &db.MFA{Secret: secret, Confirmed: true}
+
+	post := func(path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		if token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		}
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	aliceToken, err := auth.CreateToken("alice", auth.RoleEmployee)
+	assert.NoError(t, err)
+
+	t.Run("Enroll", func(t *testing.T) {
+		mockDB.EXPECT().EnrollMFA(gomock.Any(), "alice", gomock.Any(), gomock.Len(mfa.RecoveryCodeCount)).Return(nil)
+
+		w := post("/api/mfa/enroll", aliceToken, "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.MFAEnrollResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Contains(t, resp.OtpauthUri, "otpauth://totp/merch-shop:alice?")
+		assert.Len(t, resp.RecoveryCodes, mfa.RecoveryCodeCount)
+	})
+
+	t.Run("Confirm with a wrong code", func(t *testing.T) {
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(&db.MFA{Secret: secret}, nil)
+		mockDB.EXPECT().ConfirmMFA(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		w := post("/api/mfa/confirm", aliceToken, `{"code":"abcdef"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		code, err := mfa.Code(secret, time.Now())
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(&db.MFA{Secret: secret}, nil)
+		mockDB.EXPECT().ConfirmMFA(gomock.Any(), "alice", gomock.Any()).Return(nil)
+
+		w := post("/api/mfa/confirm", aliceToken, fmt.Sprintf(`{"code":%q}`, code))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	var mfaToken string
+
+	t.Run("Password step returns an mfa token", func(t *testing.T) {
+		mockDB.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(enrolled, nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Times(0)
+
+		w := post("/api/auth", "", `{"username":"alice","password":"correct-horse-battery"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Nil(t, resp.Token)
+		assert.True(t, *resp.MfaRequired)
+		mfaToken = *resp.MfaToken
+	})
+
+	t.Run("Mfa token is not an access token", func(t *testing.T) {
+		w := post("/api/mfa/enroll", mfaToken, "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Second step with a wrong code", func(t *testing.T) {
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(enrolled, nil)
+		mockDB.EXPECT().UseRecoveryCode(gomock.Any(), "alice", gomock.Any()).Return(db.ErrMFACodeInvalid)
+
+		w := post("/api/auth/mfa", "", fmt.Sprintf(`{"mfaToken":%q,"code":"000000x"}`, mfaToken))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Second step issues the session", func(t *testing.T) {
+		code, err := mfa.Code(secret, time.Now())
+		assert.NoError(t, err)
+
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(enrolled, nil)
+		mockDB.EXPECT().UseMFAStep(gomock.Any(), "alice", gomock.Any()).Return(nil)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "alice").Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		w := post("/api/auth/mfa", "", fmt.Sprintf(`{"mfaToken":%q,"code":%q}`, mfaToken, code))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.NotEmpty(t, *resp.Token)
+	})
+
+	t.Run("Small transfer needs no code", func(t *testing.T) {
+		mockDB.EXPECT().TransferCoins(gomock.Any(), "alice", "bob", 100).Return(nil)
+
+		w := post("/api/sendCoin", aliceToken, `{"toUser":"bob","amount":100}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Large transfer without a code", func(t *testing.T) {
+		mockDB.EXPECT().TransferCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		w := post("/api/sendCoin", aliceToken, `{"toUser":"bob","amount":101}`)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Large transfer without mfa enabled", func(t *testing.T) {
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(nil, db.ErrMFANotFound)
+		mockDB.EXPECT().TransferCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		w := post("/api/sendCoin", aliceToken, `{"toUser":"bob","amount":500,"mfaCode":"123456"}`)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Large transfer with a recovery code", func(t *testing.T) {
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(enrolled, nil)
+		mockDB.EXPECT().UseRecoveryCode(gomock.Any(), "alice", auth.HashOpaqueToken("abcde-fghjk")).Return(nil)
+		mockDB.EXPECT().TransferCoins(gomock.Any(), "alice", "bob", 500).Return(nil)
+
+		w := post("/api/sendCoin", aliceToken, `{"toUser":"bob","amount":500,"mfaCode":"ABCDE FGHJK"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestServiceAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	router := newRouter(NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen)), revoked)
+
+	adminToken, err := auth.CreateToken("admin", auth.RoleFinanceAdmin)
+	assert.NoError(t, err)
+
+	var key string
+
+	t.Run("Admin issues a scoped key", func(t *testing.T) {
+		var stored db.APIKey
+
+		mockDB.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, k db.APIKey) error {
+			stored = k
+
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/service-accounts/kudos-bot/keys", bytes.NewBufferString(`{"scopes":["coins:grant"]}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp api.CreateAPIKeyResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.True(t, strings.HasPrefix(resp.Key, "msk_"+resp.Prefix+"_"))
+		assert.Equal(t, auth.HashOpaqueToken(resp.Key), stored.Hash, "only the hash is stored")
+		assert.Equal(t, "kudos-bot", stored.Account)
+		assert.Equal(t, []string{"coins:grant"}, stored.Scopes)
+
+		key = resp.Key
+	})
+
+	t.Run("Unknown scope", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/service-accounts/kudos-bot/keys", bytes.NewBufferString(`{"scopes":["coins:steal"]}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", adminToken))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	keyRecord := func() *db.APIKey {
+		prefix, _ := apikey.Prefix(key)
+
+		return &db.APIKey{Prefix: prefix, Account: "kudos-bot", Scopes: []string{"coins:grant"}}
+	}
+
+	t.Run("Key grants coins", func(t *testing.T) {
+		prefix, _ := apikey.Prefix(key)
+
+		mockDB.EXPECT().AuthenticateAPIKey(gomock.Any(), prefix, auth.HashOpaqueToken(key)).Return(keyRecord(), nil)
+		mockDB.EXPECT().GrantCoins(gomock.Any(), db.CoinGrant{
+			Receiver:  "alice",
+			Amount:    10,
+			Reason:    "great demo",
+			GrantedBy: "service-account:kudos-bot",
+		}).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/coins/grant", bytes.NewBufferString(`{"toUser":"alice","amount":10,"reason":"great demo"}`))
+		req.Header.Set(middleware.APIKeyHeader, key)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Key outside its scopes", func(t *testing.T) {
+		mockDB.EXPECT().AuthenticateAPIKey(gomock.Any(), gomock.Any(), gomock.Any()).Return(keyRecord(), nil).Times(2)
+		mockDB.EXPECT().PutItem(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/catalog/socks", bytes.NewBufferString(`{"price":5}`))
+		req.Header.Set(middleware.APIKeyHeader, key)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/info", nil)
+		req.Header.Set(middleware.APIKeyHeader, key)
+		w = httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code, "service accounts cannot use employee routes")
+	})
+
+	t.Run("Revoked or expired key", func(t *testing.T) {
+		mockDB.EXPECT().AuthenticateAPIKey(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, db.ErrAPIKeyNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/coins/grant", bytes.NewBufferString(`{"toUser":"alice","amount":10}`))
+		req.Header.Set(middleware.APIKeyHeader, key)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Employees cannot grant coins", func(t *testing.T) {
+		token, err := auth.CreateToken("alice", auth.RoleEmployee)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/coins/grant", bytes.NewBufferString(`{"toUser":"alice","amount":1000}`))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+// fakeSSO stands in for the identity provider: it redirects to a fixed URL
+// and exchanges the code "good" for its identity.
+type fakeSSO struct {
+	identity *identity.Identity
+	login    identity.Login
+}
+
+func (f *fakeSSO) Name() string {
+	return "fake"
+}
+
+func (f *fakeSSO) AuthCodeURL(login identity.Login) string {
+	return "https://sso.example.com/authorize?state=" + login.State
+}
+
+func (f *fakeSSO) Exchange(_ context.Context, code string, login identity.Login) (*identity.Identity, error) {
+	f.login = login
+
+	if code != "good" {
+		return nil, identity.ErrInvalidCredentials
+	}
+
+	return f.identity, nil
+}
+
+func TestSingleSignOn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockRepository(ctrl)
+	revoked := denylist.New(mockDB)
+	sso := &fakeSSO{identity: &identity.Identity{Issuer: "https://sso.example.com", Subject: "sso-42", Username: "alice"}}
+	router := newRouter(NewService(mockDB, revoked, testPasswords, sso, testConfig(config.RegistrationOpen)), revoked)
+
+	// start begins a login and returns the state cookie set for the browser
+	start := func(t *testing.T) (*http.Cookie, db.OIDCLogin) {
+		var stored db.OIDCLogin
+
+		mockDB.EXPECT().CreateOIDCLogin(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, login db.OIDCLogin) error {
+			stored = login
+
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil))
+
+		assert.Equal(t, http.StatusFound, w.Code)
+
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.Equal(t, "https://sso.example.com/authorize?state="+cookies[0].Value, w.Header().Get("Location"))
+		assert.Equal(t, auth.HashOpaqueToken(cookies[0].Value), stored.StateHash, "only the hash of the state is stored")
+
+		return cookies[0], stored
+	}
+
+	callback := func(cookie *http.Cookie, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/callback?"+query, nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	t.Run("First login links the employee", func(t *testing.T) {
+		cookie, stored := start(t)
+
+		mockDB.EXPECT().TakeOIDCLogin(gomock.Any(), stored.StateHash).Return(&stored, nil)
+		mockDB.EXPECT().LinkIdentity(gomock.Any(), db.ExternalIdentity{
+			Issuer:   "https://sso.example.com",
+			Subject:  "sso-42",
+			Username: "alice",
+		}).Return("alice", nil)
+		mockDB.EXPECT().GetMFA(gomock.Any(), "alice").Return(nil, db.ErrMFANotFound)
+		mockDB.EXPECT().GetEmployeeRole(gomock.Any(), "alice").Return("employee", nil)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+		w := callback(cookie, "code=good&state="+cookie.Value)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, stored.Nonce, sso.login.Nonce)
+		assert.Equal(t, stored.CodeVerifier, sso.login.Verifier)
+
+		var resp api.AuthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		username, err := auth.ExtractUsername(*resp.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", username)
+	})
+
+	t.Run("State from another browser", func(t *testing.T) {
+		cookie, _ := start(t)
+
+		mockDB.EXPECT().TakeOIDCLogin(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.Equal(t, http.StatusBadRequest, callback(nil, "code=good&state="+cookie.Value).Code)
+		assert.Equal(t, http.StatusBadRequest, callback(cookie, "code=good&state=forged").Code)
+	})
+
+	t.Run("Expired or used login", func(t *testing.T) {
+		cookie, _ := start(t)
+
+		mockDB.EXPECT().TakeOIDCLogin(gomock.Any(), gomock.Any()).Return(nil, db.ErrOIDCLoginInvalid)
+
+		assert.Equal(t, http.StatusBadRequest, callback(cookie, "code=good&state="+cookie.Value).Code)
+	})
+
+	t.Run("Provider rejects the code", func(t *testing.T) {
+		cookie, stored := start(t)
+
+		mockDB.EXPECT().TakeOIDCLogin(gomock.Any(), gomock.Any()).Return(&stored, nil)
+		mockDB.EXPECT().LinkIdentity(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.Equal(t, http.StatusUnauthorized, callback(cookie, "code=bad&state="+cookie.Value).Code)
+		assert.Equal(t, http.StatusUnauthorized, callback(cookie, "error=access_denied").Code)
+	})
+
+	t.Run("Subject without an employee", func(t *testing.T) {
+		cookie, stored := start(t)
+
+		mockDB.EXPECT().TakeOIDCLogin(gomock.Any(), gomock.Any()).Return(&stored, nil)
+		mockDB.EXPECT().LinkIdentity(gomock.Any(), gomock.Any()).Return("", db.ErrIdentityNotLinked)
+		mockDB.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.Equal(t, http.StatusForbidden, callback(cookie, "code=good&state="+cookie.Value).Code)
+	})
+
+	t.Run("Not configured", func(t *testing.T) {
+		s := NewService(mockDB, revoked, testPasswords, nil, testConfig(config.RegistrationOpen))
+
+		w := httptest.NewRecorder()
+		newRouter(s, revoked).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
 }
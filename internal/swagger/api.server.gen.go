@@ -15,16 +15,47 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
 const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for CreateAPIKeyRequestScopes.
+const (
+	CatalogWrite CreateAPIKeyRequestScopes = "catalog:write"
+	CoinsGrant   CreateAPIKeyRequestScopes = "coins:grant"
+	ReadReports  CreateAPIKeyRequestScopes = "read:reports"
+)
+
+// Defines values for Role.
+const (
+	Employee     Role = "employee"
+	FinanceAdmin Role = "finance-admin"
+	ShopManager  Role = "shop-manager"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	Account   string     `json:"account"`
+	CreatedAt time.Time  `json:"createdAt"`
+	CreatedBy string     `json:"createdBy"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// LastUsedAt Last request with the key, recorded at most once a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Prefix     string     `json:"prefix"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	Scopes     []string   `json:"scopes"`
+}
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -36,14 +67,123 @@ type AuthRequest struct {
 
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
+	// MfaRequired Пароль верен, но нужен второй шаг входа через /api/auth/mfa.
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken Короткоживущий токен для /api/auth/mfa.
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// RefreshToken Одноразовый токен для получения новой пары токенов.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+
 	// Token JWT-токен для доступа к защищенным ресурсам.
 	Token *string `json:"token,omitempty"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
+// CatalogItemRequest defines model for CatalogItemRequest.
+type CatalogItemRequest struct {
+	// Price Цена товара в монетах.
+	Price int `json:"price"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	// CurrentPassword Текущий пароль.
+	CurrentPassword string `json:"currentPassword"`
+
+	// NewPassword Новый пароль.
+	NewPassword string `json:"newPassword"`
+}
+
+// CoinGrantRequest defines model for CoinGrantRequest.
+type CoinGrantRequest struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// Reason За что начислены монеты.
+	Reason *string `json:"reason,omitempty"`
+
+	// ToUser Сотрудник, которому начисляются монеты.
+	ToUser string `json:"toUser"`
+}
+
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	// ExpiresAt Время, после которого ключ перестаёт действовать. Без него ключ бессрочный.
+	ExpiresAt *time.Time                  `json:"expiresAt,omitempty"`
+	Scopes    []CreateAPIKeyRequestScopes `json:"scopes"`
+}
+
+// CreateAPIKeyRequestScopes defines model for CreateAPIKeyRequest.Scopes.
+type CreateAPIKeyRequestScopes string
+
+// CreateAPIKeyResponse defines model for CreateAPIKeyResponse.
+type CreateAPIKeyResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Key API-ключ для заголовка X-API-Key. Показывается только один раз.
+	Key string `json:"key"`
+
+	// Prefix Публичная часть ключа, по которой его можно найти и отозвать.
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+}
+
+// CreateEmployeeRequest defines model for CreateEmployeeRequest.
+type CreateEmployeeRequest struct {
+	// Password Начальный пароль.
+	Password string `json:"password"`
+
+	// Role Роль сотрудника.
+	Role *Role `json:"role,omitempty"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// CreateServiceAccountRequest defines model for CreateServiceAccountRequest.
+type CreateServiceAccountRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Имя сервисного аккаунта, например kudos-bot.
+	Name string `json:"name"`
+}
+
+// EmployeeBalance defines model for EmployeeBalance.
+type EmployeeBalance struct {
+	Balance  int    `json:"balance"`
+	Username string `json:"username"`
+}
+
+// ErrorResponse Описание проблемы по RFC 7807.
 type ErrorResponse struct {
-	// Errors Сообщение об ошибке, описывающее проблему.
-	Errors *string `json:"errors,omitempty"`
+	// Code Машиночитаемый код ошибки, например insufficient_funds.
+	Code string `json:"code"`
+
+	// Detail Постоянное пояснение к коду на английском; причина ошибки в ответ не попадает.
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Поля запроса, не соответствующие спецификации.
+	Errors *[]struct {
+		// Field Путь к полю, например body.toUser или path.item.
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	} `json:"errors,omitempty"`
+
+	// Instance Путь запроса, в котором возникла проблема.
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId Идентификатор запроса для сообщения об ошибке сервера.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP-статус ответа.
+	Status int `json:"status"`
+
+	// Title Краткое описание на языке из заголовка Accept-Language (русский или английский).
+	Title string `json:"title"`
+
+	// Type URI типа проблемы, urn:merch-shop:problem:<code>.
+	Type string `json:"type"`
 }
 
 // InfoResponse defines model for InfoResponse.
@@ -76,18 +216,197 @@ type InfoResponse struct {
 	} `json:"inventory,omitempty"`
 }
 
+// InviteResponse defines model for InviteResponse.
+type InviteResponse struct {
+	// Code Одноразовый код приглашения.
+	Code string `json:"code"`
+
+	// ExpiresAt Время, до которого код действителен.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// JWK defines model for JWK.
+type JWK struct {
+	// Alg Алгоритм подписи (RS256 или EdDSA).
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+
+	// Kid Идентификатор ключа из заголовка kid JWT-токена.
+	Kid string `json:"kid"`
+
+	// Kty Тип ключа (RSA или OKP).
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use *string `json:"use,omitempty"`
+	X   *string `json:"x,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LockoutEvent defines model for LockoutEvent.
+type LockoutEvent struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Failures Число неудачных попыток подряд.
+	Failures int   `json:"failures"`
+	Id       int64 `json:"id"`
+
+	// Key Заблокированный ключ, username:<логин> или ip:<адрес>.
+	Key         string    `json:"key"`
+	LockedUntil time.Time `json:"lockedUntil"`
+}
+
+// LogoutRequest defines model for LogoutRequest.
+type LogoutRequest struct {
+	// RefreshToken Refresh-токен текущей сессии, который нужно отозвать вместе с JWT-токеном.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code Одноразовый код из приложения или код восстановления.
+	Code string `json:"code"`
+}
+
+// MFAEnrollResponse defines model for MFAEnrollResponse.
+type MFAEnrollResponse struct {
+	// OtpauthUri otpauth:// URI для QR-кода.
+	OtpauthUri string `json:"otpauthUri"`
+
+	// RecoveryCodes Одноразовые коды восстановления. Показываются только один раз.
+	RecoveryCodes []string `json:"recoveryCodes"`
+
+	// Secret Секрет TOTP в base32.
+	Secret string `json:"secret"`
+}
+
+// MFALoginRequest defines model for MFALoginRequest.
+type MFALoginRequest struct {
+	// Code Одноразовый код из приложения или код восстановления.
+	Code string `json:"code"`
+
+	// MfaToken Токен, полученный от /api/auth.
+	MfaToken string `json:"mfaToken"`
+}
+
+// PasswordResetResponse defines model for PasswordResetResponse.
+type PasswordResetResponse struct {
+	// ExpiresAt Время, до которого токен действителен.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// ResetToken Одноразовый токен сброса пароля.
+	ResetToken string `json:"resetToken"`
+}
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	// RefreshToken Refresh-токен, полученный при аутентификации.
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RegisterRequest defines model for RegisterRequest.
+type RegisterRequest struct {
+	// InviteCode Код приглашения, обязателен в режиме регистрации invite.
+	InviteCode *string `json:"inviteCode,omitempty"`
+
+	// Password Пароль.
+	Password string `json:"password"`
+
+	// Username Имя пользователя.
+	Username string `json:"username"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// NewPassword Новый пароль.
+	NewPassword string `json:"newPassword"`
+
+	// ResetToken Одноразовый токен сброса пароля.
+	ResetToken string `json:"resetToken"`
+}
+
+// Role Роль сотрудника.
+type Role string
+
 // SendCoinRequest defines model for SendCoinRequest.
 type SendCoinRequest struct {
 	// Amount Количество монет, которые необходимо отправить.
 	Amount int `json:"amount"`
 
+	// MfaCode Одноразовый код двухфакторной аутентификации, нужен для переводов больше auth.mfa.transferThreshold.
+	MfaCode *string `json:"mfaCode,omitempty"`
+
 	// ToUser Имя пользователя, которому нужно отправить монеты.
 	ToUser string `json:"toUser"`
 }
 
+// SetRoleRequest defines model for SetRoleRequest.
+type SetRoleRequest struct {
+	// Role Роль сотрудника.
+	Role Role `json:"role"`
+}
+
+// GetApiAuthOidcCallbackParams defines parameters for GetApiAuthOidcCallback.
+type GetApiAuthOidcCallbackParams struct {
+	// Code Код авторизации.
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State Значение state, выданное при начале входа.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Код ошибки, если провайдер отказал во входе.
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// PostApiAdminEmployeesJSONRequestBody defines body for PostApiAdminEmployees for application/json ContentType.
+type PostApiAdminEmployeesJSONRequestBody = CreateEmployeeRequest
+
+// PutApiAdminEmployeesUsernameRoleJSONRequestBody defines body for PutApiAdminEmployeesUsernameRole for application/json ContentType.
+type PutApiAdminEmployeesUsernameRoleJSONRequestBody = SetRoleRequest
+
+// PostApiAdminServiceAccountsJSONRequestBody defines body for PostApiAdminServiceAccounts for application/json ContentType.
+type PostApiAdminServiceAccountsJSONRequestBody = CreateServiceAccountRequest
+
+// PostApiAdminServiceAccountsNameKeysJSONRequestBody defines body for PostApiAdminServiceAccountsNameKeys for application/json ContentType.
+type PostApiAdminServiceAccountsNameKeysJSONRequestBody = CreateAPIKeyRequest
+
 // PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
 type PostApiAuthJSONRequestBody = AuthRequest
 
+// PostApiAuthLogoutJSONRequestBody defines body for PostApiAuthLogout for application/json ContentType.
+type PostApiAuthLogoutJSONRequestBody = LogoutRequest
+
+// PostApiAuthMfaJSONRequestBody defines body for PostApiAuthMfa for application/json ContentType.
+type PostApiAuthMfaJSONRequestBody = MFALoginRequest
+
+// PostApiAuthRefreshJSONRequestBody defines body for PostApiAuthRefresh for application/json ContentType.
+type PostApiAuthRefreshJSONRequestBody = RefreshRequest
+
+// PutApiCatalogItemJSONRequestBody defines body for PutApiCatalogItem for application/json ContentType.
+type PutApiCatalogItemJSONRequestBody = CatalogItemRequest
+
+// PostApiCoinsGrantJSONRequestBody defines body for PostApiCoinsGrant for application/json ContentType.
+type PostApiCoinsGrantJSONRequestBody = CoinGrantRequest
+
+// PostApiMfaConfirmJSONRequestBody defines body for PostApiMfaConfirm for application/json ContentType.
+type PostApiMfaConfirmJSONRequestBody = MFACodeRequest
+
+// PostApiMfaDisableJSONRequestBody defines body for PostApiMfaDisable for application/json ContentType.
+type PostApiMfaDisableJSONRequestBody = MFACodeRequest
+
+// PostApiPasswordJSONRequestBody defines body for PostApiPassword for application/json ContentType.
+type PostApiPasswordJSONRequestBody = ChangePasswordRequest
+
+// PostApiPasswordResetJSONRequestBody defines body for PostApiPasswordReset for application/json ContentType.
+type PostApiPasswordResetJSONRequestBody = ResetPasswordRequest
+
+// PostApiRegisterJSONRequestBody defines body for PostApiRegister for application/json ContentType.
+type PostApiRegisterJSONRequestBody = RegisterRequest
+
 // PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
 type PostApiSendCoinJSONRequestBody = SendCoinRequest
 
@@ -164,25 +483,131 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminEmployeesWithBody request with any body
+	PostApiAdminEmployeesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminEmployees(ctx context.Context, body PostApiAdminEmployeesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminEmployeesUsernameLockout request
+	DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminEmployeesUsernamePasswordReset request
+	PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminEmployeesUsernameRoleWithBody request with any body
+	PutApiAdminEmployeesUsernameRoleWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminEmployeesUsernameRole(ctx context.Context, username string, body PutApiAdminEmployeesUsernameRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminEmployeesUsernameSessions request
+	DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminInvites request
+	PostApiAdminInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminLockouts request
+	GetApiAdminLockouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminServiceAccountsWithBody request with any body
+	PostApiAdminServiceAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminServiceAccounts(ctx context.Context, body PostApiAdminServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminServiceAccountsNameKeys request
+	GetApiAdminServiceAccountsNameKeys(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminServiceAccountsNameKeysWithBody request with any body
+	PostApiAdminServiceAccountsNameKeysWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminServiceAccountsNameKeys(ctx context.Context, name string, body PostApiAdminServiceAccountsNameKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminServiceAccountsNameKeysPrefix request
+	DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, name string, prefix string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostApiAuthWithBody request with any body
 	PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostApiAuthLogoutWithBody request with any body
+	PostApiAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthLogout(ctx context.Context, body PostApiAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthMfaWithBody request with any body
+	PostApiAuthMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthMfa(ctx context.Context, body PostApiAuthMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthOidcCallback request
+	GetApiAuthOidcCallback(ctx context.Context, params *GetApiAuthOidcCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthOidcLogin request
+	GetApiAuthOidcLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthRefreshWithBody request with any body
+	PostApiAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthRefresh(ctx context.Context, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiBuyItem request
 	GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutApiCatalogItemWithBody request with any body
+	PutApiCatalogItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiCatalogItem(ctx context.Context, item string, body PutApiCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiCoinsGrantWithBody request with any body
+	PostApiCoinsGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiCoinsGrant(ctx context.Context, body PostApiCoinsGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiInfo request
 	GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostApiMfaConfirmWithBody request with any body
+	PostApiMfaConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMfaConfirm(ctx context.Context, body PostApiMfaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMfaDisableWithBody request with any body
+	PostApiMfaDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiMfaDisable(ctx context.Context, body PostApiMfaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiMfaEnroll request
+	PostApiMfaEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPasswordWithBody request with any body
+	PostApiPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPassword(ctx context.Context, body PostApiPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPasswordResetWithBody request with any body
+	PostApiPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPasswordReset(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiRegisterWithBody request with any body
+	PostApiRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiRegister(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiReportsBalances request
+	GetApiReportsBalances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostApiSendCoinWithBody request with any body
 	PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +618,8 @@ func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequest(c.Server, body)
+func (c *Client) PostApiAdminEmployeesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminEmployeesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +630,8 @@ func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiBuyItemRequest(c.Server, item)
+func (c *Client) PostApiAdminEmployees(ctx context.Context, body PostApiAdminEmployeesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminEmployeesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +642,8 @@ func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiInfoRequest(c.Server)
+func (c *Client) DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminEmployeesUsernameLockoutRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -229,8 +654,8 @@ func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminEmployeesUsernamePasswordResetRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +666,8 @@ func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequest(c.Server, body)
+func (c *Client) PutApiAdminEmployeesUsernameRoleWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminEmployeesUsernameRoleRequestWithBody(c.Server, username, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -253,816 +678,8187 @@ func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRe
 	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
-func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutApiAdminEmployeesUsernameRole(ctx context.Context, username string, body PutApiAdminEmployeesUsernameRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminEmployeesUsernameRoleRequest(c.Server, username, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
-func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminEmployeesUsernameSessionsRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiAdminInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminInvitesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
-func NewGetApiBuyItemRequest(server string, item string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+func (c *Client) GetApiAdminLockouts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminLockoutsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiAdminServiceAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminServiceAccountsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiAdminServiceAccounts(ctx context.Context, body PostApiAdminServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminServiceAccountsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiInfoRequest generates requests for GetApiInfo
-func NewGetApiInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetApiAdminServiceAccountsNameKeys(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminServiceAccountsNameKeysRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiAdminServiceAccountsNameKeysWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminServiceAccountsNameKeysRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
-func NewPostApiSendCoinRequest(server string, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiAdminServiceAccountsNameKeys(ctx context.Context, name string, body PostApiAdminServiceAccountsNameKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminServiceAccountsNameKeysRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiSendCoinRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
-func NewPostApiSendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, name string, prefix string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminServiceAccountsNameKeysPrefixRequest(c.Server, name, prefix)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/sendCoin")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostApiAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
-
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostApiAuthWithBodyWithResponse request with any body
-	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
-
-	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
-
-	// GetApiBuyItemWithResponse request
-	GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
-
-	// GetApiInfoWithResponse request
-	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
-
-	// PostApiSendCoinWithBodyWithResponse request with any body
-	PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
-
-	PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
-}
-
-type PostApiAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r PostApiAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiAuthLogout(ctx context.Context, body PostApiAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthLogoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetApiBuyItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiBuyItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiAuthMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthMfaRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiBuyItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetApiInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InfoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiAuthMfa(ctx context.Context, body PostApiAuthMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthMfaRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type PostApiSendCoinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiSendCoinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetApiAuthOidcCallback(ctx context.Context, params *GetApiAuthOidcCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthOidcCallbackRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiSendCoinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
+	return c.Client.Do(req)
 }
 
-// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
-func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) GetApiAuthOidcLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthOidcLoginRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiAuthResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
+func (c *Client) PostApiAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRefreshRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiAuthResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
-func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
-	rsp, err := c.GetApiBuyItem(ctx, item, reqEditors...)
+func (c *Client) PostApiAuthRefresh(ctx context.Context, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRefreshRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiBuyItemResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// GetApiInfoWithResponse request returning *GetApiInfoResponse
-func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
-	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiBuyItemRequest(c.Server, item)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiInfoResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
-func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoinWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) PutApiCatalogItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiCatalogItemRequestWithBody(c.Server, item, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiSendCoinResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoin(ctx, body, reqEditors...)
+func (c *Client) PutApiCatalogItem(ctx context.Context, item string, body PutApiCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiCatalogItemRequest(c.Server, item, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiSendCoinResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
-func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *Client) PostApiCoinsGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiCoinsGrantRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &PostApiAuthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+func (c *Client) PostApiCoinsGrant(ctx context.Context, body PostApiCoinsGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiCoinsGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMfaConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMfaConfirmRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMfaConfirm(ctx context.Context, body PostApiMfaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMfaConfirmRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMfaDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMfaDisableRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMfaDisable(ctx context.Context, body PostApiMfaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMfaDisableRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiMfaEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiMfaEnrollRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPassword(ctx context.Context, body PostApiPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiPasswordReset(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRegister(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiReportsBalances(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiReportsBalancesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminEmployeesRequest calls the generic PostApiAdminEmployees builder with application/json body
+func NewPostApiAdminEmployeesRequest(server string, body PostApiAdminEmployeesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminEmployeesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminEmployeesRequestWithBody generates requests for PostApiAdminEmployees with any type of body
+func NewPostApiAdminEmployeesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/employees")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminEmployeesUsernameLockoutRequest generates requests for DeleteApiAdminEmployeesUsernameLockout
+func NewDeleteApiAdminEmployeesUsernameLockoutRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/employees/%s/lockout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminEmployeesUsernamePasswordResetRequest generates requests for PostApiAdminEmployeesUsernamePasswordReset
+func NewPostApiAdminEmployeesUsernamePasswordResetRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/employees/%s/password-reset", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminEmployeesUsernameRoleRequest calls the generic PutApiAdminEmployeesUsernameRole builder with application/json body
+func NewPutApiAdminEmployeesUsernameRoleRequest(server string, username string, body PutApiAdminEmployeesUsernameRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminEmployeesUsernameRoleRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewPutApiAdminEmployeesUsernameRoleRequestWithBody generates requests for PutApiAdminEmployeesUsernameRole with any type of body
+func NewPutApiAdminEmployeesUsernameRoleRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/employees/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminEmployeesUsernameSessionsRequest generates requests for DeleteApiAdminEmployeesUsernameSessions
+func NewDeleteApiAdminEmployeesUsernameSessionsRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/employees/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminInvitesRequest generates requests for PostApiAdminInvites
+func NewPostApiAdminInvitesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminLockoutsRequest generates requests for GetApiAdminLockouts
+func NewGetApiAdminLockoutsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/lockouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminServiceAccountsRequest calls the generic PostApiAdminServiceAccounts builder with application/json body
+func NewPostApiAdminServiceAccountsRequest(server string, body PostApiAdminServiceAccountsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminServiceAccountsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminServiceAccountsRequestWithBody generates requests for PostApiAdminServiceAccounts with any type of body
+func NewPostApiAdminServiceAccountsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/service-accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAdminServiceAccountsNameKeysRequest generates requests for GetApiAdminServiceAccountsNameKeys
+func NewGetApiAdminServiceAccountsNameKeysRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/service-accounts/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminServiceAccountsNameKeysRequest calls the generic PostApiAdminServiceAccountsNameKeys builder with application/json body
+func NewPostApiAdminServiceAccountsNameKeysRequest(server string, name string, body PostApiAdminServiceAccountsNameKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminServiceAccountsNameKeysRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewPostApiAdminServiceAccountsNameKeysRequestWithBody generates requests for PostApiAdminServiceAccountsNameKeys with any type of body
+func NewPostApiAdminServiceAccountsNameKeysRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/service-accounts/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminServiceAccountsNameKeysPrefixRequest generates requests for DeleteApiAdminServiceAccountsNameKeysPrefix
+func NewDeleteApiAdminServiceAccountsNameKeysPrefixRequest(server string, name string, prefix string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "prefix", runtime.ParamLocationPath, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/service-accounts/%s/keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
+func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
+func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthLogoutRequest calls the generic PostApiAuthLogout builder with application/json body
+func NewPostApiAuthLogoutRequest(server string, body PostApiAuthLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthLogoutRequestWithBody generates requests for PostApiAuthLogout with any type of body
+func NewPostApiAuthLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthMfaRequest calls the generic PostApiAuthMfa builder with application/json body
+func NewPostApiAuthMfaRequest(server string, body PostApiAuthMfaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthMfaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthMfaRequestWithBody generates requests for PostApiAuthMfa with any type of body
+func NewPostApiAuthMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAuthOidcCallbackRequest generates requests for GetApiAuthOidcCallback
+func NewGetApiAuthOidcCallbackRequest(server string, params *GetApiAuthOidcCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuthOidcLoginRequest generates requests for GetApiAuthOidcLogin
+func NewGetApiAuthOidcLoginRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/oidc/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthRefreshRequest calls the generic PostApiAuthRefresh builder with application/json body
+func NewPostApiAuthRefreshRequest(server string, body PostApiAuthRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRefreshRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRefreshRequestWithBody generates requests for PostApiAuthRefresh with any type of body
+func NewPostApiAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
+func NewGetApiBuyItemRequest(server string, item string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiCatalogItemRequest calls the generic PutApiCatalogItem builder with application/json body
+func NewPutApiCatalogItemRequest(server string, item string, body PutApiCatalogItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiCatalogItemRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPutApiCatalogItemRequestWithBody generates requests for PutApiCatalogItem with any type of body
+func NewPutApiCatalogItemRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/catalog/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiCoinsGrantRequest calls the generic PostApiCoinsGrant builder with application/json body
+func NewPostApiCoinsGrantRequest(server string, body PostApiCoinsGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiCoinsGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiCoinsGrantRequestWithBody generates requests for PostApiCoinsGrant with any type of body
+func NewPostApiCoinsGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coins/grant")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiInfoRequest generates requests for GetApiInfo
+func NewGetApiInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiMfaConfirmRequest calls the generic PostApiMfaConfirm builder with application/json body
+func NewPostApiMfaConfirmRequest(server string, body PostApiMfaConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMfaConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiMfaConfirmRequestWithBody generates requests for PostApiMfaConfirm with any type of body
+func NewPostApiMfaConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/mfa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiMfaDisableRequest calls the generic PostApiMfaDisable builder with application/json body
+func NewPostApiMfaDisableRequest(server string, body PostApiMfaDisableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiMfaDisableRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiMfaDisableRequestWithBody generates requests for PostApiMfaDisable with any type of body
+func NewPostApiMfaDisableRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/mfa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiMfaEnrollRequest generates requests for PostApiMfaEnroll
+func NewPostApiMfaEnrollRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/mfa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiPasswordRequest calls the generic PostApiPassword builder with application/json body
+func NewPostApiPasswordRequest(server string, body PostApiPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiPasswordRequestWithBody generates requests for PostApiPassword with any type of body
+func NewPostApiPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiPasswordResetRequest calls the generic PostApiPasswordReset builder with application/json body
+func NewPostApiPasswordResetRequest(server string, body PostApiPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiPasswordResetRequestWithBody generates requests for PostApiPasswordReset with any type of body
+func NewPostApiPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiRegisterRequest calls the generic PostApiRegister builder with application/json body
+func NewPostApiRegisterRequest(server string, body PostApiRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiRegisterRequestWithBody generates requests for PostApiRegister with any type of body
+func NewPostApiRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiReportsBalancesRequest generates requests for GetApiReportsBalances
+func NewGetApiReportsBalancesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/balances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
+func NewPostApiSendCoinRequest(server string, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSendCoinRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
+func NewPostApiSendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// PostApiAdminEmployeesWithBodyWithResponse request with any body
+	PostApiAdminEmployeesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesResponse, error)
+
+	PostApiAdminEmployeesWithResponse(ctx context.Context, body PostApiAdminEmployeesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesResponse, error)
+
+	// DeleteApiAdminEmployeesUsernameLockoutWithResponse request
+	DeleteApiAdminEmployeesUsernameLockoutWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteApiAdminEmployeesUsernameLockoutResponse, error)
+
+	// PostApiAdminEmployeesUsernamePasswordResetWithResponse request
+	PostApiAdminEmployeesUsernamePasswordResetWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesUsernamePasswordResetResponse, error)
+
+	// PutApiAdminEmployeesUsernameRoleWithBodyWithResponse request with any body
+	PutApiAdminEmployeesUsernameRoleWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminEmployeesUsernameRoleResponse, error)
+
+	PutApiAdminEmployeesUsernameRoleWithResponse(ctx context.Context, username string, body PutApiAdminEmployeesUsernameRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminEmployeesUsernameRoleResponse, error)
+
+	// DeleteApiAdminEmployeesUsernameSessionsWithResponse request
+	DeleteApiAdminEmployeesUsernameSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteApiAdminEmployeesUsernameSessionsResponse, error)
+
+	// PostApiAdminInvitesWithResponse request
+	PostApiAdminInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAdminInvitesResponse, error)
+
+	// GetApiAdminLockoutsWithResponse request
+	GetApiAdminLockoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminLockoutsResponse, error)
+
+	// PostApiAdminServiceAccountsWithBodyWithResponse request with any body
+	PostApiAdminServiceAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsResponse, error)
+
+	PostApiAdminServiceAccountsWithResponse(ctx context.Context, body PostApiAdminServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsResponse, error)
+
+	// GetApiAdminServiceAccountsNameKeysWithResponse request
+	GetApiAdminServiceAccountsNameKeysWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetApiAdminServiceAccountsNameKeysResponse, error)
+
+	// PostApiAdminServiceAccountsNameKeysWithBodyWithResponse request with any body
+	PostApiAdminServiceAccountsNameKeysWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsNameKeysResponse, error)
+
+	PostApiAdminServiceAccountsNameKeysWithResponse(ctx context.Context, name string, body PostApiAdminServiceAccountsNameKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsNameKeysResponse, error)
+
+	// DeleteApiAdminServiceAccountsNameKeysPrefixWithResponse request
+	DeleteApiAdminServiceAccountsNameKeysPrefixWithResponse(ctx context.Context, name string, prefix string, reqEditors ...RequestEditorFn) (*DeleteApiAdminServiceAccountsNameKeysPrefixResponse, error)
+
+	// PostApiAuthWithBodyWithResponse request with any body
+	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	// PostApiAuthLogoutWithBodyWithResponse request with any body
+	PostApiAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error)
+
+	PostApiAuthLogoutWithResponse(ctx context.Context, body PostApiAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error)
+
+	// PostApiAuthMfaWithBodyWithResponse request with any body
+	PostApiAuthMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthMfaResponse, error)
+
+	PostApiAuthMfaWithResponse(ctx context.Context, body PostApiAuthMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthMfaResponse, error)
+
+	// GetApiAuthOidcCallbackWithResponse request
+	GetApiAuthOidcCallbackWithResponse(ctx context.Context, params *GetApiAuthOidcCallbackParams, reqEditors ...RequestEditorFn) (*GetApiAuthOidcCallbackResponse, error)
+
+	// GetApiAuthOidcLoginWithResponse request
+	GetApiAuthOidcLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthOidcLoginResponse, error)
+
+	// PostApiAuthRefreshWithBodyWithResponse request with any body
+	PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+
+	PostApiAuthRefreshWithResponse(ctx context.Context, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error)
+
+	// GetApiBuyItemWithResponse request
+	GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
+
+	// PutApiCatalogItemWithBodyWithResponse request with any body
+	PutApiCatalogItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiCatalogItemResponse, error)
+
+	PutApiCatalogItemWithResponse(ctx context.Context, item string, body PutApiCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiCatalogItemResponse, error)
+
+	// PostApiCoinsGrantWithBodyWithResponse request with any body
+	PostApiCoinsGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiCoinsGrantResponse, error)
+
+	PostApiCoinsGrantWithResponse(ctx context.Context, body PostApiCoinsGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiCoinsGrantResponse, error)
+
+	// GetApiInfoWithResponse request
+	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
+
+	// PostApiMfaConfirmWithBodyWithResponse request with any body
+	PostApiMfaConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMfaConfirmResponse, error)
+
+	PostApiMfaConfirmWithResponse(ctx context.Context, body PostApiMfaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMfaConfirmResponse, error)
+
+	// PostApiMfaDisableWithBodyWithResponse request with any body
+	PostApiMfaDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMfaDisableResponse, error)
+
+	PostApiMfaDisableWithResponse(ctx context.Context, body PostApiMfaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMfaDisableResponse, error)
+
+	// PostApiMfaEnrollWithResponse request
+	PostApiMfaEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiMfaEnrollResponse, error)
+
+	// PostApiPasswordWithBodyWithResponse request with any body
+	PostApiPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResponse, error)
+
+	PostApiPasswordWithResponse(ctx context.Context, body PostApiPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResponse, error)
+
+	// PostApiPasswordResetWithBodyWithResponse request with any body
+	PostApiPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error)
+
+	PostApiPasswordResetWithResponse(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error)
+
+	// PostApiRegisterWithBodyWithResponse request with any body
+	PostApiRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error)
+
+	PostApiRegisterWithResponse(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error)
+
+	// GetApiReportsBalancesWithResponse request
+	GetApiReportsBalancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiReportsBalancesResponse, error)
+
+	// PostApiSendCoinWithBodyWithResponse request with any body
+	PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+
+	PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminEmployeesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminEmployeesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminEmployeesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminEmployeesUsernameLockoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminEmployeesUsernameLockoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminEmployeesUsernameLockoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminEmployeesUsernamePasswordResetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PasswordResetResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminEmployeesUsernamePasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminEmployeesUsernamePasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminEmployeesUsernameRoleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminEmployeesUsernameRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminEmployeesUsernameRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminEmployeesUsernameSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminEmployeesUsernameSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminEmployeesUsernameSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminInvitesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *InviteResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminLockoutsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]LockoutEvent
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminLockoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminLockoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminServiceAccountsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminServiceAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminServiceAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminServiceAccountsNameKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]APIKey
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminServiceAccountsNameKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminServiceAccountsNameKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminServiceAccountsNameKeysResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CreateAPIKeyResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminServiceAccountsNameKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminServiceAccountsNameKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefixResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminServiceAccountsNameKeysPrefixResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminServiceAccountsNameKeysPrefixResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthLogoutResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthMfaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthOidcCallbackResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthOidcCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthOidcCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthOidcLoginResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthOidcLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthOidcLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthRefreshResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiBuyItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiBuyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiBuyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiCatalogItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiCoinsGrantResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiCoinsGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiCoinsGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiInfoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *InfoResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMfaConfirmResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMfaConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMfaConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMfaDisableResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMfaDisableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMfaDisableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiMfaEnrollResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MFAEnrollResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiMfaEnrollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiMfaEnrollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPasswordResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPasswordResetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiRegisterResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiReportsBalancesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]EmployeeBalance
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiReportsBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiReportsBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSendCoinResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON401 *ErrorResponse
+	ApplicationproblemJSON403 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON409 *ErrorResponse
+	ApplicationproblemJSON422 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSendCoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSendCoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// PostApiAdminEmployeesWithBodyWithResponse request with arbitrary body returning *PostApiAdminEmployeesResponse
+func (c *ClientWithResponses) PostApiAdminEmployeesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesResponse, error) {
+	rsp, err := c.PostApiAdminEmployeesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminEmployeesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminEmployeesWithResponse(ctx context.Context, body PostApiAdminEmployeesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesResponse, error) {
+	rsp, err := c.PostApiAdminEmployees(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminEmployeesResponse(rsp)
+}
+
+// DeleteApiAdminEmployeesUsernameLockoutWithResponse request returning *DeleteApiAdminEmployeesUsernameLockoutResponse
+func (c *ClientWithResponses) DeleteApiAdminEmployeesUsernameLockoutWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteApiAdminEmployeesUsernameLockoutResponse, error) {
+	rsp, err := c.DeleteApiAdminEmployeesUsernameLockout(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminEmployeesUsernameLockoutResponse(rsp)
+}
+
+// PostApiAdminEmployeesUsernamePasswordResetWithResponse request returning *PostApiAdminEmployeesUsernamePasswordResetResponse
+func (c *ClientWithResponses) PostApiAdminEmployeesUsernamePasswordResetWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostApiAdminEmployeesUsernamePasswordResetResponse, error) {
+	rsp, err := c.PostApiAdminEmployeesUsernamePasswordReset(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminEmployeesUsernamePasswordResetResponse(rsp)
+}
+
+// PutApiAdminEmployeesUsernameRoleWithBodyWithResponse request with arbitrary body returning *PutApiAdminEmployeesUsernameRoleResponse
+func (c *ClientWithResponses) PutApiAdminEmployeesUsernameRoleWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminEmployeesUsernameRoleResponse, error) {
+	rsp, err := c.PutApiAdminEmployeesUsernameRoleWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminEmployeesUsernameRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminEmployeesUsernameRoleWithResponse(ctx context.Context, username string, body PutApiAdminEmployeesUsernameRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminEmployeesUsernameRoleResponse, error) {
+	rsp, err := c.PutApiAdminEmployeesUsernameRole(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminEmployeesUsernameRoleResponse(rsp)
+}
+
+// DeleteApiAdminEmployeesUsernameSessionsWithResponse request returning *DeleteApiAdminEmployeesUsernameSessionsResponse
+func (c *ClientWithResponses) DeleteApiAdminEmployeesUsernameSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteApiAdminEmployeesUsernameSessionsResponse, error) {
+	rsp, err := c.DeleteApiAdminEmployeesUsernameSessions(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminEmployeesUsernameSessionsResponse(rsp)
+}
+
+// PostApiAdminInvitesWithResponse request returning *PostApiAdminInvitesResponse
+func (c *ClientWithResponses) PostApiAdminInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAdminInvitesResponse, error) {
+	rsp, err := c.PostApiAdminInvites(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminInvitesResponse(rsp)
+}
+
+// GetApiAdminLockoutsWithResponse request returning *GetApiAdminLockoutsResponse
+func (c *ClientWithResponses) GetApiAdminLockoutsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminLockoutsResponse, error) {
+	rsp, err := c.GetApiAdminLockouts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminLockoutsResponse(rsp)
+}
+
+// PostApiAdminServiceAccountsWithBodyWithResponse request with arbitrary body returning *PostApiAdminServiceAccountsResponse
+func (c *ClientWithResponses) PostApiAdminServiceAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsResponse, error) {
+	rsp, err := c.PostApiAdminServiceAccountsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminServiceAccountsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminServiceAccountsWithResponse(ctx context.Context, body PostApiAdminServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsResponse, error) {
+	rsp, err := c.PostApiAdminServiceAccounts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminServiceAccountsResponse(rsp)
+}
+
+// GetApiAdminServiceAccountsNameKeysWithResponse request returning *GetApiAdminServiceAccountsNameKeysResponse
+func (c *ClientWithResponses) GetApiAdminServiceAccountsNameKeysWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetApiAdminServiceAccountsNameKeysResponse, error) {
+	rsp, err := c.GetApiAdminServiceAccountsNameKeys(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminServiceAccountsNameKeysResponse(rsp)
+}
+
+// PostApiAdminServiceAccountsNameKeysWithBodyWithResponse request with arbitrary body returning *PostApiAdminServiceAccountsNameKeysResponse
+func (c *ClientWithResponses) PostApiAdminServiceAccountsNameKeysWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsNameKeysResponse, error) {
+	rsp, err := c.PostApiAdminServiceAccountsNameKeysWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminServiceAccountsNameKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminServiceAccountsNameKeysWithResponse(ctx context.Context, name string, body PostApiAdminServiceAccountsNameKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminServiceAccountsNameKeysResponse, error) {
+	rsp, err := c.PostApiAdminServiceAccountsNameKeys(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminServiceAccountsNameKeysResponse(rsp)
+}
+
+// DeleteApiAdminServiceAccountsNameKeysPrefixWithResponse request returning *DeleteApiAdminServiceAccountsNameKeysPrefixResponse
+func (c *ClientWithResponses) DeleteApiAdminServiceAccountsNameKeysPrefixWithResponse(ctx context.Context, name string, prefix string, reqEditors ...RequestEditorFn) (*DeleteApiAdminServiceAccountsNameKeysPrefixResponse, error) {
+	rsp, err := c.DeleteApiAdminServiceAccountsNameKeysPrefix(ctx, name, prefix, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminServiceAccountsNameKeysPrefixResponse(rsp)
+}
+
+// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
+func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+// PostApiAuthLogoutWithBodyWithResponse request with arbitrary body returning *PostApiAuthLogoutResponse
+func (c *ClientWithResponses) PostApiAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error) {
+	rsp, err := c.PostApiAuthLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthLogoutWithResponse(ctx context.Context, body PostApiAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error) {
+	rsp, err := c.PostApiAuthLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthLogoutResponse(rsp)
+}
+
+// PostApiAuthMfaWithBodyWithResponse request with arbitrary body returning *PostApiAuthMfaResponse
+func (c *ClientWithResponses) PostApiAuthMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthMfaResponse, error) {
+	rsp, err := c.PostApiAuthMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthMfaWithResponse(ctx context.Context, body PostApiAuthMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthMfaResponse, error) {
+	rsp, err := c.PostApiAuthMfa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthMfaResponse(rsp)
+}
+
+// GetApiAuthOidcCallbackWithResponse request returning *GetApiAuthOidcCallbackResponse
+func (c *ClientWithResponses) GetApiAuthOidcCallbackWithResponse(ctx context.Context, params *GetApiAuthOidcCallbackParams, reqEditors ...RequestEditorFn) (*GetApiAuthOidcCallbackResponse, error) {
+	rsp, err := c.GetApiAuthOidcCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthOidcCallbackResponse(rsp)
+}
+
+// GetApiAuthOidcLoginWithResponse request returning *GetApiAuthOidcLoginResponse
+func (c *ClientWithResponses) GetApiAuthOidcLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthOidcLoginResponse, error) {
+	rsp, err := c.GetApiAuthOidcLogin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthOidcLoginResponse(rsp)
+}
+
+// PostApiAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostApiAuthRefreshResponse
+func (c *ClientWithResponses) PostApiAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
+	rsp, err := c.PostApiAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthRefreshWithResponse(ctx context.Context, body PostApiAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRefreshResponse, error) {
+	rsp, err := c.PostApiAuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRefreshResponse(rsp)
+}
+
+// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
+func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
+	rsp, err := c.GetApiBuyItem(ctx, item, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiBuyItemResponse(rsp)
+}
+
+// PutApiCatalogItemWithBodyWithResponse request with arbitrary body returning *PutApiCatalogItemResponse
+func (c *ClientWithResponses) PutApiCatalogItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiCatalogItemResponse, error) {
+	rsp, err := c.PutApiCatalogItemWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiCatalogItemWithResponse(ctx context.Context, item string, body PutApiCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiCatalogItemResponse, error) {
+	rsp, err := c.PutApiCatalogItem(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiCatalogItemResponse(rsp)
+}
+
+// PostApiCoinsGrantWithBodyWithResponse request with arbitrary body returning *PostApiCoinsGrantResponse
+func (c *ClientWithResponses) PostApiCoinsGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiCoinsGrantResponse, error) {
+	rsp, err := c.PostApiCoinsGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiCoinsGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiCoinsGrantWithResponse(ctx context.Context, body PostApiCoinsGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiCoinsGrantResponse, error) {
+	rsp, err := c.PostApiCoinsGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiCoinsGrantResponse(rsp)
+}
+
+// GetApiInfoWithResponse request returning *GetApiInfoResponse
+func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
+	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiInfoResponse(rsp)
+}
+
+// PostApiMfaConfirmWithBodyWithResponse request with arbitrary body returning *PostApiMfaConfirmResponse
+func (c *ClientWithResponses) PostApiMfaConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMfaConfirmResponse, error) {
+	rsp, err := c.PostApiMfaConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMfaConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMfaConfirmWithResponse(ctx context.Context, body PostApiMfaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMfaConfirmResponse, error) {
+	rsp, err := c.PostApiMfaConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMfaConfirmResponse(rsp)
+}
+
+// PostApiMfaDisableWithBodyWithResponse request with arbitrary body returning *PostApiMfaDisableResponse
+func (c *ClientWithResponses) PostApiMfaDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiMfaDisableResponse, error) {
+	rsp, err := c.PostApiMfaDisableWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMfaDisableResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiMfaDisableWithResponse(ctx context.Context, body PostApiMfaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiMfaDisableResponse, error) {
+	rsp, err := c.PostApiMfaDisable(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMfaDisableResponse(rsp)
+}
+
+// PostApiMfaEnrollWithResponse request returning *PostApiMfaEnrollResponse
+func (c *ClientWithResponses) PostApiMfaEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiMfaEnrollResponse, error) {
+	rsp, err := c.PostApiMfaEnroll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiMfaEnrollResponse(rsp)
+}
+
+// PostApiPasswordWithBodyWithResponse request with arbitrary body returning *PostApiPasswordResponse
+func (c *ClientWithResponses) PostApiPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResponse, error) {
+	rsp, err := c.PostApiPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPasswordWithResponse(ctx context.Context, body PostApiPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResponse, error) {
+	rsp, err := c.PostApiPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResponse(rsp)
+}
+
+// PostApiPasswordResetWithBodyWithResponse request with arbitrary body returning *PostApiPasswordResetResponse
+func (c *ClientWithResponses) PostApiPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error) {
+	rsp, err := c.PostApiPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPasswordResetWithResponse(ctx context.Context, body PostApiPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPasswordResetResponse, error) {
+	rsp, err := c.PostApiPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPasswordResetResponse(rsp)
+}
+
+// PostApiRegisterWithBodyWithResponse request with arbitrary body returning *PostApiRegisterResponse
+func (c *ClientWithResponses) PostApiRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error) {
+	rsp, err := c.PostApiRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiRegisterWithResponse(ctx context.Context, body PostApiRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiRegisterResponse, error) {
+	rsp, err := c.PostApiRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRegisterResponse(rsp)
+}
+
+// GetApiReportsBalancesWithResponse request returning *GetApiReportsBalancesResponse
+func (c *ClientWithResponses) GetApiReportsBalancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiReportsBalancesResponse, error) {
+	rsp, err := c.GetApiReportsBalances(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiReportsBalancesResponse(rsp)
+}
+
+// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
+func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoinWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminEmployeesResponse parses an HTTP response from a PostApiAdminEmployeesWithResponse call
+func ParsePostApiAdminEmployeesResponse(rsp *http.Response) (*PostApiAdminEmployeesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminEmployeesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminEmployeesUsernameLockoutResponse parses an HTTP response from a DeleteApiAdminEmployeesUsernameLockoutWithResponse call
+func ParseDeleteApiAdminEmployeesUsernameLockoutResponse(rsp *http.Response) (*DeleteApiAdminEmployeesUsernameLockoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminEmployeesUsernameLockoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminEmployeesUsernamePasswordResetResponse parses an HTTP response from a PostApiAdminEmployeesUsernamePasswordResetWithResponse call
+func ParsePostApiAdminEmployeesUsernamePasswordResetResponse(rsp *http.Response) (*PostApiAdminEmployeesUsernamePasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminEmployeesUsernamePasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PasswordResetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminEmployeesUsernameRoleResponse parses an HTTP response from a PutApiAdminEmployeesUsernameRoleWithResponse call
+func ParsePutApiAdminEmployeesUsernameRoleResponse(rsp *http.Response) (*PutApiAdminEmployeesUsernameRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminEmployeesUsernameRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminEmployeesUsernameSessionsResponse parses an HTTP response from a DeleteApiAdminEmployeesUsernameSessionsWithResponse call
+func ParseDeleteApiAdminEmployeesUsernameSessionsResponse(rsp *http.Response) (*DeleteApiAdminEmployeesUsernameSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminEmployeesUsernameSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminInvitesResponse parses an HTTP response from a PostApiAdminInvitesWithResponse call
+func ParsePostApiAdminInvitesResponse(rsp *http.Response) (*PostApiAdminInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InviteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminLockoutsResponse parses an HTTP response from a GetApiAdminLockoutsWithResponse call
+func ParseGetApiAdminLockoutsResponse(rsp *http.Response) (*GetApiAdminLockoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminLockoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LockoutEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminServiceAccountsResponse parses an HTTP response from a PostApiAdminServiceAccountsWithResponse call
+func ParsePostApiAdminServiceAccountsResponse(rsp *http.Response) (*PostApiAdminServiceAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminServiceAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminServiceAccountsNameKeysResponse parses an HTTP response from a GetApiAdminServiceAccountsNameKeysWithResponse call
+func ParseGetApiAdminServiceAccountsNameKeysResponse(rsp *http.Response) (*GetApiAdminServiceAccountsNameKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminServiceAccountsNameKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []APIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminServiceAccountsNameKeysResponse parses an HTTP response from a PostApiAdminServiceAccountsNameKeysWithResponse call
+func ParsePostApiAdminServiceAccountsNameKeysResponse(rsp *http.Response) (*PostApiAdminServiceAccountsNameKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminServiceAccountsNameKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAPIKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminServiceAccountsNameKeysPrefixResponse parses an HTTP response from a DeleteApiAdminServiceAccountsNameKeysPrefixWithResponse call
+func ParseDeleteApiAdminServiceAccountsNameKeysPrefixResponse(rsp *http.Response) (*DeleteApiAdminServiceAccountsNameKeysPrefixResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminServiceAccountsNameKeysPrefixResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
+func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthLogoutResponse parses an HTTP response from a PostApiAuthLogoutWithResponse call
+func ParsePostApiAuthLogoutResponse(rsp *http.Response) (*PostApiAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthMfaResponse parses an HTTP response from a PostApiAuthMfaWithResponse call
+func ParsePostApiAuthMfaResponse(rsp *http.Response) (*PostApiAuthMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthOidcCallbackResponse parses an HTTP response from a GetApiAuthOidcCallbackWithResponse call
+func ParseGetApiAuthOidcCallbackResponse(rsp *http.Response) (*GetApiAuthOidcCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthOidcCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthOidcLoginResponse parses an HTTP response from a GetApiAuthOidcLoginWithResponse call
+func ParseGetApiAuthOidcLoginResponse(rsp *http.Response) (*GetApiAuthOidcLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthOidcLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthRefreshResponse parses an HTTP response from a PostApiAuthRefreshWithResponse call
+func ParsePostApiAuthRefreshResponse(rsp *http.Response) (*PostApiAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
+func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiBuyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiCatalogItemResponse parses an HTTP response from a PutApiCatalogItemWithResponse call
+func ParsePutApiCatalogItemResponse(rsp *http.Response) (*PutApiCatalogItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiCatalogItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiCoinsGrantResponse parses an HTTP response from a PostApiCoinsGrantWithResponse call
+func ParsePostApiCoinsGrantResponse(rsp *http.Response) (*PostApiCoinsGrantResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiCoinsGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
+func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMfaConfirmResponse parses an HTTP response from a PostApiMfaConfirmWithResponse call
+func ParsePostApiMfaConfirmResponse(rsp *http.Response) (*PostApiMfaConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMfaConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMfaDisableResponse parses an HTTP response from a PostApiMfaDisableWithResponse call
+func ParsePostApiMfaDisableResponse(rsp *http.Response) (*PostApiMfaDisableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMfaDisableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiMfaEnrollResponse parses an HTTP response from a PostApiMfaEnrollWithResponse call
+func ParsePostApiMfaEnrollResponse(rsp *http.Response) (*PostApiMfaEnrollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiMfaEnrollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFAEnrollResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPasswordResponse parses an HTTP response from a PostApiPasswordWithResponse call
+func ParsePostApiPasswordResponse(rsp *http.Response) (*PostApiPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPasswordResetResponse parses an HTTP response from a PostApiPasswordResetWithResponse call
+func ParsePostApiPasswordResetResponse(rsp *http.Response) (*PostApiPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiRegisterResponse parses an HTTP response from a PostApiRegisterWithResponse call
+func ParsePostApiRegisterResponse(rsp *http.Response) (*PostApiRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest AuthResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiReportsBalancesResponse parses an HTTP response from a GetApiReportsBalancesWithResponse call
+func ParseGetApiReportsBalancesResponse(rsp *http.Response) (*GetApiReportsBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiReportsBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []EmployeeBalance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
+func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSendCoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Публичные ключи для проверки JWT-токенов другими сервисами (RS256 и EdDSA).
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request)
+	// Создать сотрудника. Доступно только роли finance-admin.
+	// (POST /api/admin/employees)
+	PostApiAdminEmployees(w http.ResponseWriter, r *http.Request)
+	// Снять блокировку входа с сотрудника. Доступно только роли finance-admin.
+	// (DELETE /api/admin/employees/{username}/lockout)
+	DeleteApiAdminEmployeesUsernameLockout(w http.ResponseWriter, r *http.Request, username string)
+	// Выдать одноразовый токен сброса пароля сотрудника. Доступно только роли finance-admin.
+	// (POST /api/admin/employees/{username}/password-reset)
+	PostApiAdminEmployeesUsernamePasswordReset(w http.ResponseWriter, r *http.Request, username string)
+	// Назначить сотруднику роль. Доступно только роли finance-admin.
+	// (PUT /api/admin/employees/{username}/role)
+	PutApiAdminEmployeesUsernameRole(w http.ResponseWriter, r *http.Request, username string)
+	// Отозвать все сессии сотрудника. Доступно только роли finance-admin.
+	// (DELETE /api/admin/employees/{username}/sessions)
+	DeleteApiAdminEmployeesUsernameSessions(w http.ResponseWriter, r *http.Request, username string)
+	// Создать одноразовый код приглашения. Доступно только роли finance-admin.
+	// (POST /api/admin/invites)
+	PostApiAdminInvites(w http.ResponseWriter, r *http.Request)
+	// Действующие блокировки входа. Доступно только роли finance-admin.
+	// (GET /api/admin/lockouts)
+	GetApiAdminLockouts(w http.ResponseWriter, r *http.Request)
+	// Создать сервисный аккаунт для бота или интеграции. Доступно только роли finance-admin.
+	// (POST /api/admin/service-accounts)
+	PostApiAdminServiceAccounts(w http.ResponseWriter, r *http.Request)
+	// API-ключи сервисного аккаунта. Доступно только роли finance-admin.
+	// (GET /api/admin/service-accounts/{name}/keys)
+	GetApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string)
+	// Выпустить API-ключ сервисного аккаунта. Ключ показывается только один раз. Доступно только роли finance-admin.
+	// (POST /api/admin/service-accounts/{name}/keys)
+	PostApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string)
+	// Отозвать API-ключ. Доступно только роли finance-admin.
+	// (DELETE /api/admin/service-accounts/{name}/keys/{prefix})
+	DeleteApiAdminServiceAccountsNameKeysPrefix(w http.ResponseWriter, r *http.Request, name string, prefix string)
+	// Аутентификация и получение JWT-токена. Неизвестный пользователь создается автоматически только в режиме регистрации implicit.
+	// (POST /api/auth)
+	PostApiAuth(w http.ResponseWriter, r *http.Request)
+	// Завершить сессию. Текущий JWT-токен и семейство переданного refresh-токена отзываются.
+	// (POST /api/auth/logout)
+	PostApiAuthLogout(w http.ResponseWriter, r *http.Request)
+	// Второй шаг входа для сотрудников с двухфакторной аутентификацией.
+	// (POST /api/auth/mfa)
+	PostApiAuthMfa(w http.ResponseWriter, r *http.Request)
+	// Завершить вход через SSO. Сюда провайдер возвращает сотрудника после входа.
+	// (GET /api/auth/oidc/callback)
+	GetApiAuthOidcCallback(w http.ResponseWriter, r *http.Request, params GetApiAuthOidcCallbackParams)
+	// Начать вход через корпоративный SSO (OpenID Connect). Перенаправляет на страницу входа провайдера.
+	// (GET /api/auth/oidc/login)
+	GetApiAuthOidcLogin(w http.ResponseWriter, r *http.Request)
+	// Обновить пару токенов по refresh-токену. Использованный refresh-токен становится недействительным.
+	// (POST /api/auth/refresh)
+	PostApiAuthRefresh(w http.ResponseWriter, r *http.Request)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string)
+	// Добавить товар в магазин или изменить его цену. Роль shop-manager или API-ключ со scope catalog:write.
+	// (PUT /api/catalog/{item})
+	PutApiCatalogItem(w http.ResponseWriter, r *http.Request, item string)
+	// Начислить монеты сотруднику из бюджета компании. Роль finance-admin или API-ключ со scope coins:grant.
+	// (POST /api/coins/grant)
+	PostApiCoinsGrant(w http.ResponseWriter, r *http.Request)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetApiInfo(w http.ResponseWriter, r *http.Request)
+	// Подтвердить подключение двухфакторной аутентификации кодом из приложения.
+	// (POST /api/mfa/confirm)
+	PostApiMfaConfirm(w http.ResponseWriter, r *http.Request)
+	// Отключить двухфакторную аутентификацию. Требует одноразовый код или код восстановления.
+	// (POST /api/mfa/disable)
+	PostApiMfaDisable(w http.ResponseWriter, r *http.Request)
+	// Начать подключение двухфакторной аутентификации (TOTP). Выдаёт секрет и коды восстановления.
+	// (POST /api/mfa/enroll)
+	PostApiMfaEnroll(w http.ResponseWriter, r *http.Request)
+	// Сменить свой пароль. Все сессии сотрудника отзываются.
+	// (POST /api/password)
+	PostApiPassword(w http.ResponseWriter, r *http.Request)
+	// Установить пароль по одноразовому токену сброса. Все сессии сотрудника отзываются.
+	// (POST /api/password/reset)
+	PostApiPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Зарегистрировать сотрудника. В режиме invite требуется код приглашения, в режиме admin самостоятельная регистрация отключена.
+	// (POST /api/register)
+	PostApiRegister(w http.ResponseWriter, r *http.Request)
+	// Балансы всех сотрудников. Роль finance-admin или API-ключ со scope read:reports.
+	// (GET /api/reports/balances)
+	GetApiReportsBalances(w http.ResponseWriter, r *http.Request)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostApiSendCoin(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Публичные ключи для проверки JWT-токенов другими сервисами (RS256 и EdDSA).
+// (GET /.well-known/jwks.json)
+func (_ Unimplemented) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать сотрудника. Доступно только роли finance-admin.
+// (POST /api/admin/employees)
+func (_ Unimplemented) PostApiAdminEmployees(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Снять блокировку входа с сотрудника. Доступно только роли finance-admin.
+// (DELETE /api/admin/employees/{username}/lockout)
+func (_ Unimplemented) DeleteApiAdminEmployeesUsernameLockout(w http.ResponseWriter, r *http.Request, username string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдать одноразовый токен сброса пароля сотрудника. Доступно только роли finance-admin.
+// (POST /api/admin/employees/{username}/password-reset)
+func (_ Unimplemented) PostApiAdminEmployeesUsernamePasswordReset(w http.ResponseWriter, r *http.Request, username string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить сотруднику роль. Доступно только роли finance-admin.
+// (PUT /api/admin/employees/{username}/role)
+func (_ Unimplemented) PutApiAdminEmployeesUsernameRole(w http.ResponseWriter, r *http.Request, username string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать все сессии сотрудника. Доступно только роли finance-admin.
+// (DELETE /api/admin/employees/{username}/sessions)
+func (_ Unimplemented) DeleteApiAdminEmployeesUsernameSessions(w http.ResponseWriter, r *http.Request, username string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать одноразовый код приглашения. Доступно только роли finance-admin.
+// (POST /api/admin/invites)
+func (_ Unimplemented) PostApiAdminInvites(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Действующие блокировки входа. Доступно только роли finance-admin.
+// (GET /api/admin/lockouts)
+func (_ Unimplemented) GetApiAdminLockouts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать сервисный аккаунт для бота или интеграции. Доступно только роли finance-admin.
+// (POST /api/admin/service-accounts)
+func (_ Unimplemented) PostApiAdminServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// API-ключи сервисного аккаунта. Доступно только роли finance-admin.
+// (GET /api/admin/service-accounts/{name}/keys)
+func (_ Unimplemented) GetApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпустить API-ключ сервисного аккаунта. Ключ показывается только один раз. Доступно только роли finance-admin.
+// (POST /api/admin/service-accounts/{name}/keys)
+func (_ Unimplemented) PostApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API-ключ. Доступно только роли finance-admin.
+// (DELETE /api/admin/service-accounts/{name}/keys/{prefix})
+func (_ Unimplemented) DeleteApiAdminServiceAccountsNameKeysPrefix(w http.ResponseWriter, r *http.Request, name string, prefix string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Аутентификация и получение JWT-токена. Неизвестный пользователь создается автоматически только в режиме регистрации implicit.
+// (POST /api/auth)
+func (_ Unimplemented) PostApiAuth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Завершить сессию. Текущий JWT-токен и семейство переданного refresh-токена отзываются.
+// (POST /api/auth/logout)
+func (_ Unimplemented) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Второй шаг входа для сотрудников с двухфакторной аутентификацией.
+// (POST /api/auth/mfa)
+func (_ Unimplemented) PostApiAuthMfa(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Завершить вход через SSO. Сюда провайдер возвращает сотрудника после входа.
+// (GET /api/auth/oidc/callback)
+func (_ Unimplemented) GetApiAuthOidcCallback(w http.ResponseWriter, r *http.Request, params GetApiAuthOidcCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Начать вход через корпоративный SSO (OpenID Connect). Перенаправляет на страницу входа провайдера.
+// (GET /api/auth/oidc/login)
+func (_ Unimplemented) GetApiAuthOidcLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить пару токенов по refresh-токену. Использованный refresh-токен становится недействительным.
+// (POST /api/auth/refresh)
+func (_ Unimplemented) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Купить предмет за монеты.
+// (GET /api/buy/{item})
+func (_ Unimplemented) GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить товар в магазин или изменить его цену. Роль shop-manager или API-ключ со scope catalog:write.
+// (PUT /api/catalog/{item})
+func (_ Unimplemented) PutApiCatalogItem(w http.ResponseWriter, r *http.Request, item string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Начислить монеты сотруднику из бюджета компании. Роль finance-admin или API-ключ со scope coins:grant.
+// (POST /api/coins/grant)
+func (_ Unimplemented) PostApiCoinsGrant(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить информацию о монетах, инвентаре и истории транзакций.
+// (GET /api/info)
+func (_ Unimplemented) GetApiInfo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подтвердить подключение двухфакторной аутентификации кодом из приложения.
+// (POST /api/mfa/confirm)
+func (_ Unimplemented) PostApiMfaConfirm(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отключить двухфакторную аутентификацию. Требует одноразовый код или код восстановления.
+// (POST /api/mfa/disable)
+func (_ Unimplemented) PostApiMfaDisable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Начать подключение двухфакторной аутентификации (TOTP). Выдаёт секрет и коды восстановления.
+// (POST /api/mfa/enroll)
+func (_ Unimplemented) PostApiMfaEnroll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сменить свой пароль. Все сессии сотрудника отзываются.
+// (POST /api/password)
+func (_ Unimplemented) PostApiPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить пароль по одноразовому токену сброса. Все сессии сотрудника отзываются.
+// (POST /api/password/reset)
+func (_ Unimplemented) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зарегистрировать сотрудника. В режиме invite требуется код приглашения, в режиме admin самостоятельная регистрация отключена.
+// (POST /api/register)
+func (_ Unimplemented) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Балансы всех сотрудников. Роль finance-admin или API-ключ со scope read:reports.
+// (GET /api/reports/balances)
+func (_ Unimplemented) GetApiReportsBalances(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отправить монеты другому пользователю.
+// (POST /api/sendCoin)
+func (_ Unimplemented) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWellKnownJwksJson(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAdminEmployees operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminEmployees(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAdminEmployees(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiAdminEmployeesUsernameLockout operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminEmployeesUsernameLockout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiAdminEmployeesUsernameLockout(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAdminEmployeesUsernamePasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminEmployeesUsernamePasswordReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAdminEmployeesUsernamePasswordReset(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiAdminEmployeesUsernameRole operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminEmployeesUsernameRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiAdminEmployeesUsernameRole(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiAdminEmployeesUsernameSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminEmployeesUsernameSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiAdminEmployeesUsernameSessions(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAdminInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAdminInvites(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiAdminLockouts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminLockouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAdminLockouts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAdminServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAdminServiceAccounts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiAdminServiceAccountsNameKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAdminServiceAccountsNameKeys(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAdminServiceAccountsNameKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAdminServiceAccountsNameKeys(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiAdminServiceAccountsNameKeysPrefix operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminServiceAccountsNameKeysPrefix(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "prefix" -------------
+	var prefix string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "prefix", chi.URLParam(r, "prefix"), &prefix, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiAdminServiceAccountsNameKeysPrefix(w, r, name, prefix)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAuth operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAuthMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthMfa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuthMfa(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiAuthOidcCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthOidcCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAuthOidcCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthOidcCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiAuthOidcLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthOidcLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthOidcLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiBuyItem operation middleware
+func (siw *ServerInterfaceWrapper) GetApiBuyItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", chi.URLParam(r, "item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiBuyItem(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) PutApiCatalogItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", chi.URLParam(r, "item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiCatalogItem(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiCoinsGrant operation middleware
+func (siw *ServerInterfaceWrapper) PostApiCoinsGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiCoinsGrant(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetApiInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiMfaConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMfaConfirm(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiMfaDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaDisable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMfaDisable(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiMfaEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostApiMfaEnroll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiMfaEnroll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiReportsBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetApiReportsBalances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiReportsBalances(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiSendCoin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiSendCoin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/employees", wrapper.PostApiAdminEmployees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/admin/employees/{username}/lockout", wrapper.DeleteApiAdminEmployeesUsernameLockout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/employees/{username}/password-reset", wrapper.PostApiAdminEmployeesUsernamePasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/admin/employees/{username}/role", wrapper.PutApiAdminEmployeesUsernameRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/admin/employees/{username}/sessions", wrapper.DeleteApiAdminEmployeesUsernameSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/invites", wrapper.PostApiAdminInvites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/admin/lockouts", wrapper.GetApiAdminLockouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/service-accounts", wrapper.PostApiAdminServiceAccounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/admin/service-accounts/{name}/keys", wrapper.GetApiAdminServiceAccountsNameKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/service-accounts/{name}/keys", wrapper.PostApiAdminServiceAccountsNameKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/admin/service-accounts/{name}/keys/{prefix}", wrapper.DeleteApiAdminServiceAccountsNameKeysPrefix)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth", wrapper.PostApiAuth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/logout", wrapper.PostApiAuthLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/mfa", wrapper.PostApiAuthMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/oidc/callback", wrapper.GetApiAuthOidcCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/oidc/login", wrapper.GetApiAuthOidcLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/refresh", wrapper.PostApiAuthRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/buy/{item}", wrapper.GetApiBuyItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/catalog/{item}", wrapper.PutApiCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/coins/grant", wrapper.PostApiCoinsGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/info", wrapper.GetApiInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/mfa/confirm", wrapper.PostApiMfaConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/mfa/disable", wrapper.PostApiMfaDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/mfa/enroll", wrapper.PostApiMfaEnroll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/password", wrapper.PostApiPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/password/reset", wrapper.PostApiPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/register", wrapper.PostApiRegister)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/reports/balances", wrapper.GetApiReportsBalances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/sendCoin", wrapper.PostApiSendCoin)
+	})
+
+	return r
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownJwksJson200ResponseHeaders struct {
+	CacheControl string
+}
+
+type GetWellKnownJwksJson200JSONResponse struct {
+	Body    JWKSet
+	Headers GetWellKnownJwksJson200ResponseHeaders
+}
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiAdminEmployeesRequestObject struct {
+	Body *PostApiAdminEmployeesJSONRequestBody
+}
+
+type PostApiAdminEmployeesResponseObject interface {
+	VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminEmployees200Response struct {
+}
+
+func (response PostApiAdminEmployees200Response) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminEmployees400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployees400ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployees401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployees401ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployees403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployees403ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployees409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployees409ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployees500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployees500ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameLockoutRequestObject struct {
+	Username string `json:"username"`
+}
+
+type DeleteApiAdminEmployeesUsernameLockoutResponseObject interface {
+	VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminEmployeesUsernameLockout200Response struct {
+}
+
+func (response DeleteApiAdminEmployeesUsernameLockout200Response) VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminEmployeesUsernameLockout401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameLockout401ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameLockout403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameLockout403ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameLockout500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameLockout500ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployeesUsernamePasswordResetRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostApiAdminEmployeesUsernamePasswordResetResponseObject interface {
+	VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminEmployeesUsernamePasswordReset200JSONResponse PasswordResetResponse
+
+func (response PostApiAdminEmployeesUsernamePasswordReset200JSONResponse) VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployeesUsernamePasswordReset401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployeesUsernamePasswordReset401ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployeesUsernamePasswordReset403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployeesUsernamePasswordReset403ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployeesUsernamePasswordReset404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployeesUsernamePasswordReset404ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminEmployeesUsernamePasswordReset500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminEmployeesUsernamePasswordReset500ApplicationProblemPlusJSONResponse) VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminEmployeesUsernameRoleRequestObject struct {
+	Username string `json:"username"`
+	Body     *PutApiAdminEmployeesUsernameRoleJSONRequestBody
+}
+
+type PutApiAdminEmployeesUsernameRoleResponseObject interface {
+	VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error
+}
+
+type PutApiAdminEmployeesUsernameRole200Response struct {
+}
+
+func (response PutApiAdminEmployeesUsernameRole200Response) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiAdminEmployeesUsernameRole400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiAdminEmployeesUsernameRole400ApplicationProblemPlusJSONResponse) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminEmployeesUsernameRole401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiAdminEmployeesUsernameRole401ApplicationProblemPlusJSONResponse) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminEmployeesUsernameRole403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiAdminEmployeesUsernameRole403ApplicationProblemPlusJSONResponse) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminEmployeesUsernameRole404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiAdminEmployeesUsernameRole404ApplicationProblemPlusJSONResponse) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiAdminEmployeesUsernameRole500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiAdminEmployeesUsernameRole500ApplicationProblemPlusJSONResponse) VisitPutApiAdminEmployeesUsernameRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameSessionsRequestObject struct {
+	Username string `json:"username"`
+}
+
+type DeleteApiAdminEmployeesUsernameSessionsResponseObject interface {
+	VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminEmployeesUsernameSessions200Response struct {
+}
+
+func (response DeleteApiAdminEmployeesUsernameSessions200Response) VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminEmployeesUsernameSessions401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameSessions401ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameSessions403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameSessions403ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameSessions404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameSessions404ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminEmployeesUsernameSessions500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminEmployeesUsernameSessions500ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminInvitesRequestObject struct {
+}
+
+type PostApiAdminInvitesResponseObject interface {
+	VisitPostApiAdminInvitesResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminInvites200JSONResponse InviteResponse
+
+func (response PostApiAdminInvites200JSONResponse) VisitPostApiAdminInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminInvites401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminInvites401ApplicationProblemPlusJSONResponse) VisitPostApiAdminInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminInvites403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminInvites403ApplicationProblemPlusJSONResponse) VisitPostApiAdminInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminInvites500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminInvites500ApplicationProblemPlusJSONResponse) VisitPostApiAdminInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLockoutsRequestObject struct {
+}
+
+type GetApiAdminLockoutsResponseObject interface {
+	VisitGetApiAdminLockoutsResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminLockouts200JSONResponse []LockoutEvent
+
+func (response GetApiAdminLockouts200JSONResponse) VisitGetApiAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLockouts401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminLockouts401ApplicationProblemPlusJSONResponse) VisitGetApiAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLockouts403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminLockouts403ApplicationProblemPlusJSONResponse) VisitGetApiAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminLockouts500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminLockouts500ApplicationProblemPlusJSONResponse) VisitGetApiAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsRequestObject struct {
+	Body *PostApiAdminServiceAccountsJSONRequestBody
+}
+
+type PostApiAdminServiceAccountsResponseObject interface {
+	VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminServiceAccounts200Response struct {
+}
+
+func (response PostApiAdminServiceAccounts200Response) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAdminServiceAccounts400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts400ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts401ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts403ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts409ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccounts500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccounts500ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeysRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetApiAdminServiceAccountsNameKeysResponseObject interface {
+	VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error
+}
+
+type GetApiAdminServiceAccountsNameKeys200JSONResponse []APIKey
+
+func (response GetApiAdminServiceAccountsNameKeys200JSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys401ApplicationProblemPlusJSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys403ApplicationProblemPlusJSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAdminServiceAccountsNameKeys500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAdminServiceAccountsNameKeys500ApplicationProblemPlusJSONResponse) VisitGetApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeysRequestObject struct {
+	Name string `json:"name"`
+	Body *PostApiAdminServiceAccountsNameKeysJSONRequestBody
+}
+
+type PostApiAdminServiceAccountsNameKeysResponseObject interface {
+	VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error
+}
+
+type PostApiAdminServiceAccountsNameKeys200JSONResponse CreateAPIKeyResponse
+
+func (response PostApiAdminServiceAccountsNameKeys200JSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys400ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys401ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys403ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys404ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAdminServiceAccountsNameKeys500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAdminServiceAccountsNameKeys500ApplicationProblemPlusJSONResponse) VisitPostApiAdminServiceAccountsNameKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject struct {
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject interface {
+	VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefix200Response struct {
+}
+
+func (response DeleteApiAdminServiceAccountsNameKeysPrefix200Response) VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefix401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminServiceAccountsNameKeysPrefix401ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefix403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminServiceAccountsNameKeysPrefix403ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefix404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminServiceAccountsNameKeysPrefix404ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiAdminServiceAccountsNameKeysPrefix500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response DeleteApiAdminServiceAccountsNameKeysPrefix500ApplicationProblemPlusJSONResponse) VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRequestObject struct {
+	Body *PostApiAuthJSONRequestBody
+}
+
+type PostApiAuthResponseObject interface {
+	VisitPostApiAuthResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuth200JSONResponse AuthResponse
+
+func (response PostApiAuth200JSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuth400ApplicationProblemPlusJSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuth401ApplicationProblemPlusJSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuth429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostApiAuth429ApplicationProblemPlusJSONResponse struct {
+	Body    ErrorResponse
+	Headers PostApiAuth429ResponseHeaders
+}
+
+func (response PostApiAuth429ApplicationProblemPlusJSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiAuth500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuth500ApplicationProblemPlusJSONResponse) VisitPostApiAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthLogoutRequestObject struct {
+	Body *PostApiAuthLogoutJSONRequestBody
+}
+
+type PostApiAuthLogoutResponseObject interface {
+	VisitPostApiAuthLogoutResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthLogout200Response struct {
+}
+
+func (response PostApiAuthLogout200Response) VisitPostApiAuthLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiAuthLogout400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthLogout400ApplicationProblemPlusJSONResponse) VisitPostApiAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthLogout401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthLogout401ApplicationProblemPlusJSONResponse) VisitPostApiAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthLogout500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthLogout500ApplicationProblemPlusJSONResponse) VisitPostApiAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfaRequestObject struct {
+	Body *PostApiAuthMfaJSONRequestBody
+}
+
+type PostApiAuthMfaResponseObject interface {
+	VisitPostApiAuthMfaResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthMfa200JSONResponse AuthResponse
+
+func (response PostApiAuthMfa200JSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthMfa400ApplicationProblemPlusJSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthMfa401ApplicationProblemPlusJSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthMfa429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostApiAuthMfa429ApplicationProblemPlusJSONResponse struct {
+	Body    ErrorResponse
+	Headers PostApiAuthMfa429ResponseHeaders
+}
+
+func (response PostApiAuthMfa429ApplicationProblemPlusJSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiAuthMfa500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthMfa500ApplicationProblemPlusJSONResponse) VisitPostApiAuthMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcCallbackRequestObject struct {
+	Params GetApiAuthOidcCallbackParams
+}
+
+type GetApiAuthOidcCallbackResponseObject interface {
+	VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetApiAuthOidcCallback200ResponseHeaders struct {
+	SetCookie string
+}
+
+type GetApiAuthOidcCallback200JSONResponse struct {
+	Body    AuthResponse
+	Headers GetApiAuthOidcCallback200ResponseHeaders
+}
+
+func (response GetApiAuthOidcCallback200JSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApiAuthOidcCallback400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcCallback400ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcCallback401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcCallback401ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcCallback403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcCallback403ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcCallback404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcCallback404ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcCallback500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcCallback500ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcLoginRequestObject struct {
+}
+
+type GetApiAuthOidcLoginResponseObject interface {
+	VisitGetApiAuthOidcLoginResponse(w http.ResponseWriter) error
+}
+
+type GetApiAuthOidcLogin302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type GetApiAuthOidcLogin302Response struct {
+	Headers GetApiAuthOidcLogin302ResponseHeaders
+}
+
+func (response GetApiAuthOidcLogin302Response) VisitGetApiAuthOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetApiAuthOidcLogin404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcLogin404ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAuthOidcLogin500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiAuthOidcLogin500ApplicationProblemPlusJSONResponse) VisitGetApiAuthOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRefreshRequestObject struct {
+	Body *PostApiAuthRefreshJSONRequestBody
+}
+
+type PostApiAuthRefreshResponseObject interface {
+	VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error
+}
+
+type PostApiAuthRefresh200JSONResponse AuthResponse
+
+func (response PostApiAuthRefresh200JSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRefresh400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthRefresh400ApplicationProblemPlusJSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRefresh401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthRefresh401ApplicationProblemPlusJSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAuthRefresh500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiAuthRefresh500ApplicationProblemPlusJSONResponse) VisitPostApiAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItemRequestObject struct {
+	Item string `json:"item"`
+}
+
+type GetApiBuyItemResponseObject interface {
+	VisitGetApiBuyItemResponse(w http.ResponseWriter) error
+}
+
+type GetApiBuyItem200Response struct {
+}
+
+func (response GetApiBuyItem200Response) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetApiBuyItem400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiBuyItem400ApplicationProblemPlusJSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiBuyItem401ApplicationProblemPlusJSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiBuyItem404ApplicationProblemPlusJSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiBuyItem409ApplicationProblemPlusJSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiBuyItem500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiBuyItem500ApplicationProblemPlusJSONResponse) VisitGetApiBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiCatalogItemRequestObject struct {
+	Item string `json:"item"`
+	Body *PutApiCatalogItemJSONRequestBody
+}
+
+type PutApiCatalogItemResponseObject interface {
+	VisitPutApiCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type PutApiCatalogItem200Response struct {
+}
+
+func (response PutApiCatalogItem200Response) VisitPutApiCatalogItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiCatalogItem400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiCatalogItem400ApplicationProblemPlusJSONResponse) VisitPutApiCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiCatalogItem401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiCatalogItem401ApplicationProblemPlusJSONResponse) VisitPutApiCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiCatalogItem403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiCatalogItem403ApplicationProblemPlusJSONResponse) VisitPutApiCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiCatalogItem500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PutApiCatalogItem500ApplicationProblemPlusJSONResponse) VisitPutApiCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrantRequestObject struct {
+	Body *PostApiCoinsGrantJSONRequestBody
+}
+
+type PostApiCoinsGrantResponseObject interface {
+	VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error
+}
+
+type PostApiCoinsGrant200Response struct {
+}
+
+func (response PostApiCoinsGrant200Response) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiCoinsGrant400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant400ApplicationProblemPlusJSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant401ApplicationProblemPlusJSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant403ApplicationProblemPlusJSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant404ApplicationProblemPlusJSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiCoinsGrant500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiCoinsGrant500ApplicationProblemPlusJSONResponse) VisitPostApiCoinsGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfoRequestObject struct {
+}
+
+type GetApiInfoResponseObject interface {
+	VisitGetApiInfoResponse(w http.ResponseWriter) error
+}
+
+type GetApiInfo200JSONResponse InfoResponse
+
+func (response GetApiInfo200JSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiInfo400ApplicationProblemPlusJSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiInfo401ApplicationProblemPlusJSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiInfo500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiInfo500ApplicationProblemPlusJSONResponse) VisitGetApiInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirmRequestObject struct {
+	Body *PostApiMfaConfirmJSONRequestBody
+}
+
+type PostApiMfaConfirmResponseObject interface {
+	VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaConfirm200Response struct {
+}
+
+func (response PostApiMfaConfirm200Response) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiMfaConfirm400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm400ApplicationProblemPlusJSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirm401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm401ApplicationProblemPlusJSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaConfirm500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaConfirm500ApplicationProblemPlusJSONResponse) VisitPostApiMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisableRequestObject struct {
+	Body *PostApiMfaDisableJSONRequestBody
+}
+
+type PostApiMfaDisableResponseObject interface {
+	VisitPostApiMfaDisableResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaDisable200Response struct {
+}
+
+func (response PostApiMfaDisable200Response) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiMfaDisable400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaDisable400ApplicationProblemPlusJSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisable401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaDisable401ApplicationProblemPlusJSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaDisable429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostApiMfaDisable429ApplicationProblemPlusJSONResponse struct {
+	Body    ErrorResponse
+	Headers PostApiMfaDisable429ResponseHeaders
+}
+
+func (response PostApiMfaDisable429ApplicationProblemPlusJSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiMfaDisable500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaDisable500ApplicationProblemPlusJSONResponse) VisitPostApiMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnrollRequestObject struct {
+}
+
+type PostApiMfaEnrollResponseObject interface {
+	VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostApiMfaEnroll200JSONResponse MFAEnrollResponse
+
+func (response PostApiMfaEnroll200JSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll401ApplicationProblemPlusJSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll409ApplicationProblemPlusJSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiMfaEnroll500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiMfaEnroll500ApplicationProblemPlusJSONResponse) VisitPostApiMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordRequestObject struct {
+	Body *PostApiPasswordJSONRequestBody
+}
+
+type PostApiPasswordResponseObject interface {
+	VisitPostApiPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostApiPassword200Response struct {
+}
+
+func (response PostApiPassword200Response) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiPassword400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiPassword400ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiPassword401ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPassword429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostApiPassword429ApplicationProblemPlusJSONResponse struct {
+	Body    ErrorResponse
+	Headers PostApiPassword429ResponseHeaders
+}
+
+func (response PostApiPassword429ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiPassword500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiPassword500ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordResetRequestObject struct {
+	Body *PostApiPasswordResetJSONRequestBody
+}
+
+type PostApiPasswordResetResponseObject interface {
+	VisitPostApiPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostApiPasswordReset200Response struct {
+}
+
+func (response PostApiPasswordReset200Response) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiPasswordReset400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiPasswordReset400ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiPasswordReset500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiPasswordReset500ApplicationProblemPlusJSONResponse) VisitPostApiPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegisterRequestObject struct {
+	Body *PostApiRegisterJSONRequestBody
+}
+
+type PostApiRegisterResponseObject interface {
+	VisitPostApiRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostApiRegister200JSONResponse AuthResponse
+
+func (response PostApiRegister200JSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiRegister400ApplicationProblemPlusJSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiRegister403ApplicationProblemPlusJSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiRegister409ApplicationProblemPlusJSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiRegister500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiRegister500ApplicationProblemPlusJSONResponse) VisitPostApiRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiReportsBalancesRequestObject struct {
+}
+
+type GetApiReportsBalancesResponseObject interface {
+	VisitGetApiReportsBalancesResponse(w http.ResponseWriter) error
+}
+
+type GetApiReportsBalances200JSONResponse []EmployeeBalance
+
+func (response GetApiReportsBalances200JSONResponse) VisitGetApiReportsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiReportsBalances401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiReportsBalances401ApplicationProblemPlusJSONResponse) VisitGetApiReportsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiReportsBalances403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiReportsBalances403ApplicationProblemPlusJSONResponse) VisitGetApiReportsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiReportsBalances500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response GetApiReportsBalances500ApplicationProblemPlusJSONResponse) VisitGetApiReportsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoinRequestObject struct {
+	Body *PostApiSendCoinJSONRequestBody
+}
+
+type PostApiSendCoinResponseObject interface {
+	VisitPostApiSendCoinResponse(w http.ResponseWriter) error
+}
+
+type PostApiSendCoin200Response struct {
+}
+
+func (response PostApiSendCoin200Response) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostApiSendCoin400ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin400ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin401ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin401ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin403ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin403ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin404ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin404ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin409ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin409ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin422ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin422ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSendCoin429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostApiSendCoin429ApplicationProblemPlusJSONResponse struct {
+	Body    ErrorResponse
+	Headers PostApiSendCoin429ResponseHeaders
+}
+
+func (response PostApiSendCoin429ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostApiSendCoin500ApplicationProblemPlusJSONResponse ErrorResponse
+
+func (response PostApiSendCoin500ApplicationProblemPlusJSONResponse) VisitPostApiSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Публичные ключи для проверки JWT-токенов другими сервисами (RS256 и EdDSA).
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// Создать сотрудника. Доступно только роли finance-admin.
+	// (POST /api/admin/employees)
+	PostApiAdminEmployees(ctx context.Context, request PostApiAdminEmployeesRequestObject) (PostApiAdminEmployeesResponseObject, error)
+	// Снять блокировку входа с сотрудника. Доступно только роли finance-admin.
+	// (DELETE /api/admin/employees/{username}/lockout)
+	DeleteApiAdminEmployeesUsernameLockout(ctx context.Context, request DeleteApiAdminEmployeesUsernameLockoutRequestObject) (DeleteApiAdminEmployeesUsernameLockoutResponseObject, error)
+	// Выдать одноразовый токен сброса пароля сотрудника. Доступно только роли finance-admin.
+	// (POST /api/admin/employees/{username}/password-reset)
+	PostApiAdminEmployeesUsernamePasswordReset(ctx context.Context, request PostApiAdminEmployeesUsernamePasswordResetRequestObject) (PostApiAdminEmployeesUsernamePasswordResetResponseObject, error)
+	// Назначить сотруднику роль. Доступно только роли finance-admin.
+	// (PUT /api/admin/employees/{username}/role)
+	PutApiAdminEmployeesUsernameRole(ctx context.Context, request PutApiAdminEmployeesUsernameRoleRequestObject) (PutApiAdminEmployeesUsernameRoleResponseObject, error)
+	// Отозвать все сессии сотрудника. Доступно только роли finance-admin.
+	// (DELETE /api/admin/employees/{username}/sessions)
+	DeleteApiAdminEmployeesUsernameSessions(ctx context.Context, request DeleteApiAdminEmployeesUsernameSessionsRequestObject) (DeleteApiAdminEmployeesUsernameSessionsResponseObject, error)
+	// Создать одноразовый код приглашения. Доступно только роли finance-admin.
+	// (POST /api/admin/invites)
+	PostApiAdminInvites(ctx context.Context, request PostApiAdminInvitesRequestObject) (PostApiAdminInvitesResponseObject, error)
+	// Действующие блокировки входа. Доступно только роли finance-admin.
+	// (GET /api/admin/lockouts)
+	GetApiAdminLockouts(ctx context.Context, request GetApiAdminLockoutsRequestObject) (GetApiAdminLockoutsResponseObject, error)
+	// Создать сервисный аккаунт для бота или интеграции. Доступно только роли finance-admin.
+	// (POST /api/admin/service-accounts)
+	PostApiAdminServiceAccounts(ctx context.Context, request PostApiAdminServiceAccountsRequestObject) (PostApiAdminServiceAccountsResponseObject, error)
+	// API-ключи сервисного аккаунта. Доступно только роли finance-admin.
+	// (GET /api/admin/service-accounts/{name}/keys)
+	GetApiAdminServiceAccountsNameKeys(ctx context.Context, request GetApiAdminServiceAccountsNameKeysRequestObject) (GetApiAdminServiceAccountsNameKeysResponseObject, error)
+	// Выпустить API-ключ сервисного аккаунта. Ключ показывается только один раз. Доступно только роли finance-admin.
+	// (POST /api/admin/service-accounts/{name}/keys)
+	PostApiAdminServiceAccountsNameKeys(ctx context.Context, request PostApiAdminServiceAccountsNameKeysRequestObject) (PostApiAdminServiceAccountsNameKeysResponseObject, error)
+	// Отозвать API-ключ. Доступно только роли finance-admin.
+	// (DELETE /api/admin/service-accounts/{name}/keys/{prefix})
+	DeleteApiAdminServiceAccountsNameKeysPrefix(ctx context.Context, request DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject) (DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject, error)
+	// Аутентификация и получение JWT-токена. Неизвестный пользователь создается автоматически только в режиме регистрации implicit.
+	// (POST /api/auth)
+	PostApiAuth(ctx context.Context, request PostApiAuthRequestObject) (PostApiAuthResponseObject, error)
+	// Завершить сессию. Текущий JWT-токен и семейство переданного refresh-токена отзываются.
+	// (POST /api/auth/logout)
+	PostApiAuthLogout(ctx context.Context, request PostApiAuthLogoutRequestObject) (PostApiAuthLogoutResponseObject, error)
+	// Второй шаг входа для сотрудников с двухфакторной аутентификацией.
+	// (POST /api/auth/mfa)
+	PostApiAuthMfa(ctx context.Context, request PostApiAuthMfaRequestObject) (PostApiAuthMfaResponseObject, error)
+	// Завершить вход через SSO. Сюда провайдер возвращает сотрудника после входа.
+	// (GET /api/auth/oidc/callback)
+	GetApiAuthOidcCallback(ctx context.Context, request GetApiAuthOidcCallbackRequestObject) (GetApiAuthOidcCallbackResponseObject, error)
+	// Начать вход через корпоративный SSO (OpenID Connect). Перенаправляет на страницу входа провайдера.
+	// (GET /api/auth/oidc/login)
+	GetApiAuthOidcLogin(ctx context.Context, request GetApiAuthOidcLoginRequestObject) (GetApiAuthOidcLoginResponseObject, error)
+	// Обновить пару токенов по refresh-токену. Использованный refresh-токен становится недействительным.
+	// (POST /api/auth/refresh)
+	PostApiAuthRefresh(ctx context.Context, request PostApiAuthRefreshRequestObject) (PostApiAuthRefreshResponseObject, error)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetApiBuyItem(ctx context.Context, request GetApiBuyItemRequestObject) (GetApiBuyItemResponseObject, error)
+	// Добавить товар в магазин или изменить его цену. Роль shop-manager или API-ключ со scope catalog:write.
+	// (PUT /api/catalog/{item})
+	PutApiCatalogItem(ctx context.Context, request PutApiCatalogItemRequestObject) (PutApiCatalogItemResponseObject, error)
+	// Начислить монеты сотруднику из бюджета компании. Роль finance-admin или API-ключ со scope coins:grant.
+	// (POST /api/coins/grant)
+	PostApiCoinsGrant(ctx context.Context, request PostApiCoinsGrantRequestObject) (PostApiCoinsGrantResponseObject, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetApiInfo(ctx context.Context, request GetApiInfoRequestObject) (GetApiInfoResponseObject, error)
+	// Подтвердить подключение двухфакторной аутентификации кодом из приложения.
+	// (POST /api/mfa/confirm)
+	PostApiMfaConfirm(ctx context.Context, request PostApiMfaConfirmRequestObject) (PostApiMfaConfirmResponseObject, error)
+	// Отключить двухфакторную аутентификацию. Требует одноразовый код или код восстановления.
+	// (POST /api/mfa/disable)
+	PostApiMfaDisable(ctx context.Context, request PostApiMfaDisableRequestObject) (PostApiMfaDisableResponseObject, error)
+	// Начать подключение двухфакторной аутентификации (TOTP). Выдаёт секрет и коды восстановления.
+	// (POST /api/mfa/enroll)
+	PostApiMfaEnroll(ctx context.Context, request PostApiMfaEnrollRequestObject) (PostApiMfaEnrollResponseObject, error)
+	// Сменить свой пароль. Все сессии сотрудника отзываются.
+	// (POST /api/password)
+	PostApiPassword(ctx context.Context, request PostApiPasswordRequestObject) (PostApiPasswordResponseObject, error)
+	// Установить пароль по одноразовому токену сброса. Все сессии сотрудника отзываются.
+	// (POST /api/password/reset)
+	PostApiPasswordReset(ctx context.Context, request PostApiPasswordResetRequestObject) (PostApiPasswordResetResponseObject, error)
+	// Зарегистрировать сотрудника. В режиме invite требуется код приглашения, в режиме admin самостоятельная регистрация отключена.
+	// (POST /api/register)
+	PostApiRegister(ctx context.Context, request PostApiRegisterRequestObject) (PostApiRegisterResponseObject, error)
+	// Балансы всех сотрудников. Роль finance-admin или API-ключ со scope read:reports.
+	// (GET /api/reports/balances)
+	GetApiReportsBalances(ctx context.Context, request GetApiReportsBalancesRequestObject) (GetApiReportsBalancesResponseObject, error)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostApiSendCoin(ctx context.Context, request PostApiSendCoinRequestObject) (PostApiSendCoinResponseObject, error)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
-	}
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
 
-	return response, nil
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
-func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx, request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
 	if err != nil {
-		return nil, err
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownJwksJsonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	response := &GetApiBuyItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostApiAdminEmployees operation middleware
+func (sh *strictHandler) PostApiAdminEmployees(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAdminEmployeesRequestObject
+
+	var body PostApiAdminEmployeesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminEmployees(ctx, request.(PostApiAdminEmployeesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminEmployees")
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	response, err := handler(r.Context(), w, r, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAdminEmployeesResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminEmployeesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON500 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminEmployeesUsernameLockout operation middleware
+func (sh *strictHandler) DeleteApiAdminEmployeesUsernameLockout(w http.ResponseWriter, r *http.Request, username string) {
+	var request DeleteApiAdminEmployeesUsernameLockoutRequestObject
+
+	request.Username = username
 
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminEmployeesUsernameLockout(ctx, request.(DeleteApiAdminEmployeesUsernameLockoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminEmployeesUsernameLockout")
 	}
 
-	return response, nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
-func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiAdminEmployeesUsernameLockoutResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminEmployeesUsernameLockoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	response := &GetApiInfoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PostApiAdminEmployeesUsernamePasswordReset operation middleware
+func (sh *strictHandler) PostApiAdminEmployeesUsernamePasswordReset(w http.ResponseWriter, r *http.Request, username string) {
+	var request PostApiAdminEmployeesUsernamePasswordResetRequestObject
+
+	request.Username = username
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminEmployeesUsernamePasswordReset(ctx, request.(PostApiAdminEmployeesUsernamePasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminEmployeesUsernamePasswordReset")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InfoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	response, err := handler(r.Context(), w, r, request)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAdminEmployeesUsernamePasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminEmployeesUsernamePasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON400 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// PutApiAdminEmployeesUsernameRole operation middleware
+func (sh *strictHandler) PutApiAdminEmployeesUsernameRole(w http.ResponseWriter, r *http.Request, username string) {
+	var request PutApiAdminEmployeesUsernameRoleRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	request.Username = username
+
+	var body PutApiAdminEmployeesUsernameRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiAdminEmployeesUsernameRole(ctx, request.(PutApiAdminEmployeesUsernameRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiAdminEmployeesUsernameRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiAdminEmployeesUsernameRoleResponseObject); ok {
+		if err := validResponse.VisitPutApiAdminEmployeesUsernameRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON500 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiAdminEmployeesUsernameSessions operation middleware
+func (sh *strictHandler) DeleteApiAdminEmployeesUsernameSessions(w http.ResponseWriter, r *http.Request, username string) {
+	var request DeleteApiAdminEmployeesUsernameSessionsRequestObject
+
+	request.Username = username
 
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminEmployeesUsernameSessions(ctx, request.(DeleteApiAdminEmployeesUsernameSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminEmployeesUsernameSessions")
 	}
 
-	return response, nil
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiAdminEmployeesUsernameSessionsResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminEmployeesUsernameSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
-func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostApiAdminInvites operation middleware
+func (sh *strictHandler) PostApiAdminInvites(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAdminInvitesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminInvites(ctx, request.(PostApiAdminInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminInvites")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
 	if err != nil {
-		return nil, err
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAdminInvitesResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminInvitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	response := &PostApiSendCoinResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetApiAdminLockouts operation middleware
+func (sh *strictHandler) GetApiAdminLockouts(w http.ResponseWriter, r *http.Request) {
+	var request GetApiAdminLockoutsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminLockouts(ctx, request.(GetApiAdminLockoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminLockouts")
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiAdminLockoutsResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminLockoutsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON400 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+// PostApiAdminServiceAccounts operation middleware
+func (sh *strictHandler) PostApiAdminServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAdminServiceAccountsRequestObject
+
+	var body PostApiAdminServiceAccountsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminServiceAccounts(ctx, request.(PostApiAdminServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminServiceAccounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAdminServiceAccountsResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminServiceAccountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON401 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+// GetApiAdminServiceAccountsNameKeys operation middleware
+func (sh *strictHandler) GetApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetApiAdminServiceAccountsNameKeysRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAdminServiceAccountsNameKeys(ctx, request.(GetApiAdminServiceAccountsNameKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAdminServiceAccountsNameKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiAdminServiceAccountsNameKeysResponseObject); ok {
+		if err := validResponse.VisitGetApiAdminServiceAccountsNameKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
-		response.JSON500 = &dest
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
+// PostApiAdminServiceAccountsNameKeys operation middleware
+func (sh *strictHandler) PostApiAdminServiceAccountsNameKeys(w http.ResponseWriter, r *http.Request, name string) {
+	var request PostApiAdminServiceAccountsNameKeysRequestObject
+
+	request.Name = name
+
+	var body PostApiAdminServiceAccountsNameKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	return response, nil
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAdminServiceAccountsNameKeys(ctx, request.(PostApiAdminServiceAccountsNameKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAdminServiceAccountsNameKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAdminServiceAccountsNameKeysResponseObject); ok {
+		if err := validResponse.VisitPostApiAdminServiceAccountsNameKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostApiAuth(w http.ResponseWriter, r *http.Request)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetApiInfo(w http.ResponseWriter, r *http.Request)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostApiSendCoin(w http.ResponseWriter, r *http.Request)
+// DeleteApiAdminServiceAccountsNameKeysPrefix operation middleware
+func (sh *strictHandler) DeleteApiAdminServiceAccountsNameKeysPrefix(w http.ResponseWriter, r *http.Request, name string, prefix string) {
+	var request DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject
+
+	request.Name = name
+	request.Prefix = prefix
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiAdminServiceAccountsNameKeysPrefix(ctx, request.(DeleteApiAdminServiceAccountsNameKeysPrefixRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiAdminServiceAccountsNameKeysPrefix")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiAdminServiceAccountsNameKeysPrefixResponseObject); ok {
+		if err := validResponse.VisitDeleteApiAdminServiceAccountsNameKeysPrefixResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// PostApiAuth operation middleware
+func (sh *strictHandler) PostApiAuth(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAuthRequestObject
 
-type Unimplemented struct{}
+	var body PostApiAuthJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-// (POST /api/auth)
-func (_ Unimplemented) PostApiAuth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuth(ctx, request.(PostApiAuthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAuthResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Купить предмет за монеты.
-// (GET /api/buy/{item})
-func (_ Unimplemented) GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostApiAuthLogout operation middleware
+func (sh *strictHandler) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAuthLogoutRequestObject
+
+	var body PostApiAuthLogoutJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthLogout(ctx, request.(PostApiAuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthLogout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAuthLogoutResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Получить информацию о монетах, инвентаре и истории транзакций.
-// (GET /api/info)
-func (_ Unimplemented) GetApiInfo(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostApiAuthMfa operation middleware
+func (sh *strictHandler) PostApiAuthMfa(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAuthMfaRequestObject
+
+	var body PostApiAuthMfaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthMfa(ctx, request.(PostApiAuthMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthMfa")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAuthMfaResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthMfaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Отправить монеты другому пользователю.
-// (POST /api/sendCoin)
-func (_ Unimplemented) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetApiAuthOidcCallback operation middleware
+func (sh *strictHandler) GetApiAuthOidcCallback(w http.ResponseWriter, r *http.Request, params GetApiAuthOidcCallbackParams) {
+	var request GetApiAuthOidcCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuthOidcCallback(ctx, request.(GetApiAuthOidcCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuthOidcCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiAuthOidcCallbackResponseObject); ok {
+		if err := validResponse.VisitGetApiAuthOidcCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// GetApiAuthOidcLogin operation middleware
+func (sh *strictHandler) GetApiAuthOidcLogin(w http.ResponseWriter, r *http.Request) {
+	var request GetApiAuthOidcLoginRequestObject
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAuthOidcLogin(ctx, request.(GetApiAuthOidcLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAuthOidcLogin")
+	}
 
-// PostApiAuth operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAuth(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	response, err := handler(r.Context(), w, r, request)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiAuthOidcLoginResponseObject); ok {
+		if err := validResponse.VisitGetApiAuthOidcLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiAuth(w, r)
-	}))
+// PostApiAuthRefresh operation middleware
+func (sh *strictHandler) PostApiAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	var request PostApiAuthRefreshRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	var body PostApiAuthRefreshJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAuthRefresh(ctx, request.(PostApiAuthRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAuthRefresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiAuthRefreshResponseObject); ok {
+		if err := validResponse.VisitPostApiAuthRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // GetApiBuyItem operation middleware
-func (siw *ServerInterfaceWrapper) GetApiBuyItem(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (sh *strictHandler) GetApiBuyItem(w http.ResponseWriter, r *http.Request, item string) {
+	var request GetApiBuyItemRequestObject
 
-	var err error
+	request.Item = item
 
-	// ------------- Path parameter "item" -------------
-	var item string
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiBuyItem(ctx, request.(GetApiBuyItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiBuyItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item", chi.URLParam(r, "item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiBuyItemResponseObject); ok {
+		if err := validResponse.VisitGetApiBuyItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiCatalogItem operation middleware
+func (sh *strictHandler) PutApiCatalogItem(w http.ResponseWriter, r *http.Request, item string) {
+	var request PutApiCatalogItemRequestObject
+
+	request.Item = item
+
+	var body PutApiCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
+	request.Body = &body
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiCatalogItem(ctx, request.(PutApiCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiCatalogItem")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiBuyItem(w, r, item)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiCatalogItemResponseObject); ok {
+		if err := validResponse.VisitPutApiCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostApiCoinsGrant operation middleware
+func (sh *strictHandler) PostApiCoinsGrant(w http.ResponseWriter, r *http.Request) {
+	var request PostApiCoinsGrantRequestObject
+
+	var body PostApiCoinsGrantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiCoinsGrant(ctx, request.(PostApiCoinsGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiCoinsGrant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiCoinsGrantResponseObject); ok {
+		if err := validResponse.VisitPostApiCoinsGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // GetApiInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetApiInfo(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (sh *strictHandler) GetApiInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetApiInfoRequestObject
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiInfo(ctx, request.(GetApiInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiInfo")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiInfo(w, r)
-	}))
+	response, err := handler(r.Context(), w, r, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiInfoResponseObject); ok {
+		if err := validResponse.VisitGetApiInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostApiSendCoin operation middleware
-func (siw *ServerInterfaceWrapper) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PostApiMfaConfirm operation middleware
+func (sh *strictHandler) PostApiMfaConfirm(w http.ResponseWriter, r *http.Request) {
+	var request PostApiMfaConfirmRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiSendCoin(w, r)
-	}))
+	var body PostApiMfaConfirmJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaConfirm(ctx, request.(PostApiMfaConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaConfirm")
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMfaConfirmResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// PostApiMfaDisable operation middleware
+func (sh *strictHandler) PostApiMfaDisable(w http.ResponseWriter, r *http.Request) {
+	var request PostApiMfaDisableRequestObject
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var body PostApiMfaDisableJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaDisable(ctx, request.(PostApiMfaDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaDisable")
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMfaDisableResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaDisableResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// PostApiMfaEnroll operation middleware
+func (sh *strictHandler) PostApiMfaEnroll(w http.ResponseWriter, r *http.Request) {
+	var request PostApiMfaEnrollRequestObject
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiMfaEnroll(ctx, request.(PostApiMfaEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiMfaEnroll")
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiMfaEnrollResponseObject); ok {
+		if err := validResponse.VisitPostApiMfaEnrollResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// PostApiPassword operation middleware
+func (sh *strictHandler) PostApiPassword(w http.ResponseWriter, r *http.Request) {
+	var request PostApiPasswordRequestObject
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var body PostApiPasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPassword(ctx, request.(PostApiPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPassword")
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiPasswordResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+// PostApiPasswordReset operation middleware
+func (sh *strictHandler) PostApiPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var request PostApiPasswordResetRequestObject
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	var body PostApiPasswordResetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiPasswordReset(ctx, request.(PostApiPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiPasswordReset")
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostApiPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// PostApiRegister operation middleware
+func (sh *strictHandler) PostApiRegister(w http.ResponseWriter, r *http.Request) {
+	var request PostApiRegisterRequestObject
+
+	var body PostApiRegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiRegister(ctx, request.(PostApiRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiRegister")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiRegisterResponseObject); ok {
+		if err := validResponse.VisitPostApiRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetApiReportsBalances operation middleware
+func (sh *strictHandler) GetApiReportsBalances(w http.ResponseWriter, r *http.Request) {
+	var request GetApiReportsBalancesRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiReportsBalances(ctx, request.(GetApiReportsBalancesRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiReportsBalances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiReportsBalancesResponseObject); ok {
+		if err := validResponse.VisitGetApiReportsBalancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+}
+
+// PostApiSendCoin operation middleware
+func (sh *strictHandler) PostApiSendCoin(w http.ResponseWriter, r *http.Request) {
+	var request PostApiSendCoinRequestObject
+
+	var body PostApiSendCoinJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth", wrapper.PostApiAuth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/buy/{item}", wrapper.GetApiBuyItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/info", wrapper.GetApiInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/sendCoin", wrapper.PostApiSendCoin)
-	})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiSendCoin(ctx, request.(PostApiSendCoinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiSendCoin")
+	}
 
-	return r
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiSendCoinResponseObject); ok {
+		if err := validResponse.VisitPostApiSendCoinResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXy27bRhT9FWLaJSspfQCBdnbRh7MKmhRZGF7Q0thianHomaELwRCgR9wmsGEXXRUB",
-	"WqPtD9CqVNOyRP3CnT8q7iVlvW0ZsQMU8U4cjnjPnDl3zpl9VhBlX3jc04rl95kqlHjZoZ8rgS59x3cD",
-	"rjQ++lL4XGqX00vfUepHIYv4u8hVQbq+doXH8gxOITQ1iOHSHFnQhktzYkFomqYBHeibBkTmFUTQhdD8",
-	"BBFEGWazLSHLjmb50Wdtpis+Z3mmtHS9bVa1WaC49Jwyn1PyN+hhlUFSFc4hhhaEVJHKL4diqmLVZpLv",
-	"Bq7kRZZfH5W3Ryg3rv4kNl/ygkaYCW3KF57is7xp8QP3Zlfw5MXzT0wDYugivCvAbYhN3TRMEwYQWtC1",
-	"4BxC8wYi8wbnQd8cQs8yNeiYummamqlDCL35a5kB+pWUQi5GyvG1mkP2nxBDDGcphAg6Fj5aEJvXEMEZ",
-	"LsHGoQFEpm4OaSeOaXbHggFJ4wwuoQM901wS6pq3JRYjLQjX+9ZVWsjK7EvJC9zd4yRUV/Oymp3ilEXg",
-	"6TkrfYt6gsj8TPw2oAXxUGRNHEx2wBxY0IMY+tAxjbEFuZ7m21wi/i0pyt8rLm8tXduCLsSoDFMzh3Bh",
-	"0QOSGEILIrgcK20Ol2QzHXCkdCr4rHiy+juhZxzf5S0o0uKdCYIYJTUHgjl8d5rmzUDhqWWJGe/l5Shx",
-	"vT3uDVW9YHN2A8fTrq4srV48LKANPSyLVC7YDRqZ+eRfEMFg+iPhnfH5jHvFL4XrLXSd20nxit6pLupY",
-	"OExn2AHE0IYIp061lmmYo3tXat804V/ozy2+hGTH7SlFZQ85mrUm6vRCIF1deYYun1C6yh3JJZoWPm3S",
-	"09dDO37y4jmzk0yAX0rejqCUtPZZtUpS3RJkb67ewTcrT9eslT1XC0uVhM9stselSmh6lMllcsij8Lnn",
-	"+C7Ls89oCH1VlwhU1vHdrJNi8kUiBRSCg1yvFVmePRVKr/guAU+Y4EqvimIlcQRPp4ea4/s7boH+l32p",
-	"hDcKOfjrY8m3WJ59lB2loGwagbLj+ac6SbeWAaeBxJII86e53B2XTv2Oak9J7W9ThwF0zGvoQ3httDEn",
-	"GST78ztENxkc5sH7HTrQgo6pUUK5oNiSWL+pp3AevWc4IbTS3ouGnQl9wvLFe6XmV2x606ATFL3xBHdv",
-	"FJ1Cy9SJuIS+MJP0bVAuO+gDDH5ZvNEWRNPxBNPZZLaEMGPBKdJgkYKwUoxbdE04XnSmHSHYGM6hDSGd",
-	"U3WSYsI09GheeiZDFzM2roV6ezOoZPfR0qrI6Daf0+DfcOzv1aCypnmZDgfplLnmmEnX95nr0XWBuj+5",
-	"FZBFsuk2tcd2bvoE3Zjfwot7bRTBWoltP3TW/72zUkckTY174foGymOs8d5SckuteSIB0SZM2fWV0ofO",
-	"eI3G8XbD7tFNJm5PN7jJg8I/XIWfXllHqvII+uYVrbuXesyxNRGsITQHNs3DmmQdIaIjJ4ooiSesRRbB",
-	"DqFP29klX7kYaxOVRv8bA9/wjnBPoW/6CrJ88HvoqYeemu2pP6691lnQNjXThH+GF8L5Oes4k0C/qSyX",
-	"e8N4FMid9IKWz2Z3RMHZKQml849zj3OsulH9LwAA//8p9wCT9xUAAA==",
+	"H4sIAAAAAAAC/+x9bW8bR5L/Vxnw/39h4yjR62R3c7pXspLsOnYuPslGDsgZxohsShORM8xMUw5hCJDI",
+	"OI4hr7UxAtzCOG82CXD7lqLFiJJI+it0f6NDVXfPE3vI0QMlRx4gCCxyOP1U9avHrnqUKzrVmmMTm3q5",
+	"uUc5r7hKqib+c/7OzVukAf+quU6NuNQi+LlZLDp1m8I/aaNGcnM5j7qWvZLbyOeKLjEpKc3jt2XHrZo0",
+	"N5crmZTMUKtKcvnEn9xoaF9Ivq5ZLvGO88KK6dF7nppEiXhF16pRy7Fzc7nbpkcNl3xVJx41Hlp01aCr",
+	"xFgjjbzhkqLjlkjJMKlRdTxqOHaRGKZRtew6JbO5fMrhay4pW19rF+OSdWfteLvjFZ2a2HaLkqqnfa38",
+	"wHRds5HbwHG+qlsuKeXmvlDTyfvH5r8zvPXhk7vvv9FZ/pIUKQwxX6eri2LbRgmiZnreQ8ctjW43+5G1",
+	"+SYbsiP+zGB77IjvGKzNW7zJumzAm6zHv2E9dsja/FvWY73ILvuv1WxL3SOubVaJZsi/sT6M8kaMyvbZ",
+	"kHVYG0fE4dPNIjZibFP94fPBLJO3zas5tkdG961aNhf9l47fug7r8k2Ybd5gAzY02IC32K/wgcE6vMmG",
+	"+OiBwb9jbfYaPnvMhmyPtQ3+RP503yiYNatg1ulqoVo2Q4tcdpwKMW2YcLVs3nXWiK2Zz0sxCG+yQzZk",
+	"v7Ie6/AWf8p6MGyTDdmhmI7Y36SxwsxQdom3mjTc39kerJRvsrY4Q76tHUgedAvWyQasBx8N4HncD/YG",
+	"dpFvh34I32knRPUz+eTzuzOaYffYkG/xJm/BEAY7NNg+a8N28Kc4yoBvs74BO8+3eItv8i3WZn09aY3Q",
+	"zYJJzYqzcpOSajLXuVZRR///i8O3xYo7SERtg3UM1mdDNmBd3mRt/jg0EcumZIW4GuSAAXRkvbBq2ivk",
+	"jqT8xBkW665LbHonGR5+Zl126FOROCxB8toTssnDMS975VPJhBfF1hmfZnQc7fody/6Ta9o0celmVYnI",
+	"US5iR6yHXLnFm0CmoZPRnQpM1vQcHY/8N/I3HDTQfJs/YT2+xY4Q1bZDr+XbCQR/zyOu5r0/AZvzTd5C",
+	"Huyxw7wBTO/jTJ+3IgPyHf6cN/kW35k0aGzr5Qzyar+0m42CSSgjifsdURNiq3mB8NfnO3kEC7FD0QW9",
+	"hh08ZEf8OX8CD3Ul3wKrfM+bwO1ddqAOTMgT/mzWYN8jsMKCY+/Yxd9vIWI+QTA4SK9CaIQ+setVJFbH",
+	"sr25FSA+ENoCJ+YeuhaFN7nELM25pOa41Mvd17x5rKogh518CEkC7QTK2hppjB7Z/J2bM8FWSsDdB8mG",
+	"3AMHcMjaxn/OwIO3SGPWYD8iQLfZPt+G42FdSY+8KfWAQzgeEIk9NjCEVJkdr77F5TFvsV3Ju0D7OyBa",
+	"2ygDnvkHz9qCyKLkdWAo+uij6BxIhmUHoHwY8B8+zPZ90pqKLgibnQ80wonn/VG1VnEahJxE83uF8NDG",
+	"vR9oUTmVouc6FSS0/++Scm4u9/8KgblSkLZKYRGeOY1SeHYKn9i3JeKuW0UyLzTuxN2LzFFzlmPXwrcQ",
+	"pTqIwAOFYW12iHpsC/Xadl6Q2Ru+yXqsDz8w1uolx5tZdujkReP4ulUqurhhVky7qAGC5eCLUXEWPqbU",
+	"u67eqJ2O6zpuGJVGVMk3uEttFGZdA/djiMwMcmFbcOzixwvGHz+49sfZXD62mqJT0r32f1ibfwdwghDf",
+	"g/0W7wNaBx15D9ganthlh6ynOQvL9urlslW0iE0flOt2ydPyfYlQ06poTQTUQdmQ74DGyYa4OPgTaaKr",
+	"1nso5yPFtoE78RqwDIQaftf/N7EtgG498VBo7qg/DlH6dUEcDuRAwNJgZcR0l2DqBI7G0089gHU8Dzig",
+	"vHg132LDYDghdnmLP0c9Eb8GEf2tzm7zkTF6hGWLVEp6UJf4rcDhueaclp1SY1boKgbrwb4ZNZOuzsJg",
+	"2mVXieeZKykoXMwr+IGOvKOQns9ZtkcVdyUtJ76rnZgCZ6Aesy+0O3YExx1hCtZOsNkQym6WtLC0N2JO",
+	"43ix2SiBLg6Z7fKngeE2ZLthuusGKAf/18/Joyata0jsz3fv3pmROlyTt/hWiIIjrwpBE7VoRbevL2F0",
+	"afl2DTaMAwraWzuofMCsWY/ti1VHFZb5YpHU6Mxt016pmyvEuAI6NqiJwGPsQNHWCHv22MFVvQbfqGmm",
+	"e2/xJug9PWGZxsAub9Rde65K3OLqjLfq1OZqrrNcIdW5/6pfu/ZeEbAO/0VSaO/wrdo0/yDyAi91lHzT",
+	"LjvJ2iOotn+2POq4GqejS4rEWheOkgQeP57NFXUbDPg2fzzRECu7TjXBZBqvYITtJyEf4I83qIZ24NRT",
+	"2E0TccEjNj2z7QnP7+gYW0SdU2+QMjBHpjDZpp28Tbon0KZKuzFhv0+6LbHsdWIrqk44nK/qpk0t2khN",
+	"vWjT7qF8asY8WuHT0OID+xmwIf6S9pnt50173aJkHKOXSGqPn9KkhDwGVAStS0oMvc6R0hewFzfTpAWP",
+	"w4UN/p6gUhg0rQ0fdzHBisMz06HjJ5/f0nBtZUWzjL+yI5gt6mtN1hdstSelUs+4srh0/fd/UOLko9KH",
+	"S/N6+VF01/VRF+2na9Yx5b5vFCdKxDWrZEQdrAlSfo02kgk5GOfK4tK8Wvdnt+7oV623tuqeftVfT9bi",
+	"YHJie/J4YAmnu0Q0RuAaaURN+nGWLpBICiNfb9PfdoprTp1+tE5szTxOELkrm1al7hIddP5TeiLRz9FF",
+	"R2KbP1GAiZYD3xaHLomXb/IdtpeAoKXIpCyb/uF97YNabxK4SVH/AQrrIad3UMUaKIBB6skbytyUqhD+",
+	"4jVYQ/g3UXRl1dQDbZg2wHKixpTPVZziGinds6lViSwiPXggWQnXjb/j0fdOit7ddlacerIfYnwkZlF8",
+	"G46B8Kbvt++yA6GkgxbbQys3pumISNVAKRUhJxcYIX0p1UDVjyPBMHW85NOP5xecUrKf6kQSByFLiJ0j",
+	"dNv5ZopU0+VzHdQI0NCQkaejMfJJJxnu65f0ke06lUqyHHVoDSJs91xrdG3yu7lCwQBzQNpc/7E4I2ad",
+	"aN8VnXXiNmAzvZQb1lXOhe3xWzHio/VjBpN8tGm9naACF11CtRENINhN9F7c/ezuHbCIl02PvHd98hHJ",
+	"l+bD+x3fqoQTvO2sWPZvgSrHRn5/VhyZ15hN0pgJAr6TN9QfaoypGAQXPUJTRhyOq+tFo7qn0vdggR6h",
+	"Jw5m8y22GzhIAkd5CgQJDTxJw5RQfmaCIIkekDxPmWIRmYt+MSuWR4mbuBoLzZAFPZO9HGNV5NERBf4c",
+	"ZaDKRAsM52PqQ591xR+vQdGBYKlamSGG1ceWUiXKTD8J5uziHcieExMBzihu/7Yw2aT0gEVH60T8h1gY",
+	"Oj6j4XUhjVWIl8joSi6fAwfdTNW0zRWMkpctGxy/M2apatna+O4SsUuQnXDWiQkxta4rQt7AJY+lwO7H",
+	"vUa9WBwzpKZXy+bCSWTfHoYBHvNvIM4lJgNPs4OxWJOPZEv5WUMiK6qD0x+Cg3xXnA/ggIGCDDKXqGva",
+	"Xpm4d1cBjZxK6XiZFMf3esXU5chuTiG7YolQINdkkZA6/hrnGPhwdEShpNVdizaW4OdilPmadYs0IFtO",
+	"AHduLrdKzBJOXiBczg/2B8s28VewihvEdImrfr+Mf32sIPSTz+9isBtGy83Jb4O3rFJay21soKOu7MDv",
+	"ZRQA0hCM+XWLOgbwYS6fWyeuJw72d7PXZq/ByE6N2GbNys3l3sOPAC/pKi6qMPuQVCoza7bz0C58+XDN",
+	"m/1SpvGsCB0V9tkESoF4Su5PhH5OKpVb8PgnD9e8T+BhhDzUffCV169dE4qjTaURb9ZqFauIbymo14tz",
+	"SeFLAIcErjxGtb/ICNt3IQVPBE6A6MTJ4HwWzOIqmVlwbOo6FS2oCMdML5L5cMj/AiEeZYkLygaqFqFI",
+	"3xLogx3AW2LUYFFxot8IE1Vu7ov7+ZxXr1ZNtyFDYkHahjJX/FkpNJBzAUyAcOeIIdox0EPRQn9An/Wi",
+	"4fc264e9br7HDSYm9GIA7IICduH/dTwNEdxxPDpfs+bh+Y/8x/3A2w2n1DgzAtDneGxEGZm6dbKhp8Jj",
+	"EM1GPvf+WNKVQah/Od4KooF/HSW/QoiHk5KTCoUi5bR+d0HTavuJuz0lF9hAzum9C5qTDG5ID+4Twa5S",
+	"BMmp/esFTG0kH9EQ8tzA1NqnSmPhLZ/afn8h1PZCwJXI1GYDvoMxbT+c3R4JZ8eQ61FEkH1xfyMKZT+h",
+	"82xPIqZWjTTYD+H4lDJyFaQKhZf1jIgmmQxUhUfKENgoVIT7WHB+hVAyil4f4ucj+HVPvkM6oFFGumaV",
+	"UJQiX0iBD3IzEPchAyQKRuMkwf2zAaoMEY6BCJeU0+CVqJfEAweHvBW5XrF17oyoDPIZNEqPqUsoXoz4",
+	"186dI89Ef9G7CI+rz2Ycf3wd4P23QgcQWYiYRi1C0JcXj17wbV/us6HWR5LGz3XuUKW8B7W6Dp/qyfCE",
+	"LoXpotLZG1QxX0pmSWUomqHoW4SirxAw1b01vQ3FW4YfgZgiMHrEA0+idwprakm9IjOnMljIYOHksPD3",
+	"kawkeF8kq2maipOIF6f0Cd+UD0/RrIpl72b2VOZBObWvMsFmGZ/bfTbsJd2W3ri4m+Ku2+rZU7JXqlze",
+	"SDruaFJvxnUZ1x2b634IsthC9yVHfZisF/JhnhGjeeLS84ysM5RSoEWvSk831Km/lp2Z6RlMvH0Bz9Dd",
+	"fnGOkZv9WfzzWawAgm6TVHbHLnJm28+QhqySJuRuBlmbU8LAwiNh7qtLPpMUkBga/rtZJbdII52Jf+6x",
+	"mVRajiwimOk3mX5zegQI1wWK52AllUA5MWfnj6+9nAO/Tks3itbXSq8TTWEKp3E8ZCpX5gKdksr1joWb",
+	"32BlkqYMlEQqsqXF3ZdBQb0TFGebvkZWeCRKoW2kj8IkAP4dVVJtCrCf177GL+KWhXMyLEtIu3+Xgzhh",
+	"wDo9lMiLJeMVwjpdlfx41ipauPr2OatmkQrWE1QyLIqZfBOL70iWyJS0tMB2/WKcT3Bb5jtRFhBu4fgi",
+	"fnIpj8CpHL0otEio25iZL1PtLbl/+iXKY1eAABMgJ4MN2F7kCtEbNgx2DRk+NJND3tLeGAoKXW/8ZmEw",
+	"inp/TeY1rCobq43OuqPFdgykwR5Cp/Aj+tVadXcWRc6McMP5epwiYagbyJvqEimGGaIqXsob1FU4B4vG",
+	"ELhQwTImqYBYVDyZEhxHy6lsSETO4gXnhouXUoOBKkX4U/6dhLQgA4U/nzVixfLjbQmEO4z1gwigwMmu",
+	"LPLWZgMfyN2RSg6i3mszXpolzoHVspmK/T4tm1PivXhRlUwdumxsH5mWjNjImeH/w5VGIgwgHh2XaZIp",
+	"VZlSNVmpejG2n06oenEsIRBuifOtE1ap6LIDMa0AbB2rVCwUzUpl2SyuTYoe1unqZ1apuKAeH3FF6evf",
+	"RGVsuI42PPVVnbiNwOckq1iOdVWNlN4T6dZK/fSoSQnUoxYXWkJVy0XJoIHqGsC6oU1PmhC+7Zgzeqkr",
+	"zN7lWyGgYR3lMeGbQiyi8xKmZQixqmbWTZoZ1j3PXdTNuDOWUhHQWSJ0ZsFx1ixd+ZZf8FCP+A4WWivi",
+	"YwbWmn4AJTQeeJ7zAA9tUkWHt1EuBtIoAEXWDoihbUiXeRuB6AiB5qIk6Y+jhOwX7d+TdscmOtyP/BVc",
+	"oDP1F/4EGu0ocsRdR7vwmbG09JnfFaAjinKxQeLNW6wZeWGO1xdiI8NN1/zp49qEoTv8jftiJxkuiqBi",
+	"+zBrsJ/4cyFFNUArOhJ0YET+VHgXtHn3kS5OIRmhkZ4VMBVSik40K+KZv+9du64t1taVe9eOFUlX7TZG",
+	"lsdbMRy97YhD1lZ3lmVlDeUYgTfzb/l2aL2aMYSgHCMMx4L3QjJa533e883Dp4JR/YPeMvhfQHaAcruL",
+	"R9hi+zgpWcV1Etpn7HpO7CobMyUyKpYk20QeE00veqwjpSFszpXPasS++aGx4Ng2KdKrWNdVww5SB5BQ",
+	"HSHj1mQyjvKy9Fekcj3IKpVTcj/EKmi+zd4H2alkN1r9FeRorBVm5oS4/L7HWLDUpwrfzIcuqS0jXvPs",
+	"jdZZyFuzBvsbklokROC7ZVxNyfBwJeKeihsM0DU5UnlXtozrh3Bgud4oPIKM040J4vxGvQFtU1PlYlji",
+	"wXNPociYLXVmx0WoBT+rxrmjCRTGFXlnDUjnge3QB2WnbpeuXuBdgcQ8FL9WqBDCbBf9Km02wOut/kJG",
+	"etBdvbwxlpeYAKJAL9z+BvkhVl/VBx/ZZjUEQGMKmoR6N08RhKaQBzzaczq7GpXltl36Gwb5aPlhzZ3K",
+	"IUKnUpaCvuqyqzr0NAL3cC8UhYJj7MubzPAj2f+Xf+vrT6oaeLjEt/p5PNt2aGCrXiPS7jmMT45lewXR",
+	"FHqSjQTVwT1sXj6tu5bx5ugZimQokhU8easx7lXQy19TaV5fJkl0otlFh+6vooOgCLP3wZxkA3mtUuFc",
+	"JLd2ItAFXe5DMKfqs4+x/qDD6HSLo5SdsUeXYVaW5DXGAPnRT8iUbAZ3kb/B9fdlwPW5ETHeWJs/zuNz",
+	"rCMDtBhfxPzOHt/ydw9yLaWTdR8zDyCWH04qqJbNQtGxy5ZbnaglfAodOsSjU8vjCndsu+Q6gt+7y48f",
+	"7ynYC8JGftThiZCWGWeeN2dGAuNBApTmrE7WiUYSgkgBS2rkFuPYkuWZyxWShmM/lI9mHHvWHKs/7kmZ",
+	"M5KlOxHyaWe3TdInRgZnAmmRmtRO+FjxVCdLj7ywq3d+UQaxL1p+gYpU4/gFc9xxkruiks2ksnHHaHgZ",
+	"gVSCfVXTIKrowDpNg2K0zeulKY1yEWjyw8mAWpZT0kL1ZS2FHCSBnK2OcwX660JaiCpa/z1vKlAVDXh9",
+	"pp3UMjhg23DnzLFM6zdmnJJzc9W0V0i84eW74+EM2TB+p04/O3MYTD9ckExeJcTgPhBK923ELLUw3ozc",
+	"shpZp9yZ7m9FZ4pfJZENL9vRHu6v2TC81J1Mk7qgAnfhmBHkXAq4DbfFBVxNVZ560oU+BamFdG2E4g2D",
+	"ppNdp+km/O6Aa35SQpT2wtupkfgyZLT9MpJf9iy2M2/YUGNPyK674dy2SPucs2M3V7Yrn8hoqq/51Hgs",
+	"2jb9bUth1UTm9lk7VinA7x3rGxtvaVjlIsKu/xgtqiB4qxm1buIGtLYKeyIkse6FGnrvSkvQkas2SZww",
+	"rifoi2jZDdFxwuDNsLNFJOSOI4X8SP0OEcuVDZCHMha1E4gsNLx1RT609BgBy5rjUq+wbFYgaDypdu6i",
+	"ePyGevo8Ctuqfjxy0KzCbZZ/Np3cjO+DtF1xAQze/zjhAvpJEy5cYpbmJNeF+NAjdgnyuSYqLUvqwWl1",
+	"1hOvfydtgo5QT/uGn6IjgsVSmQeruhfH3C3eYn1IKQjlea+bFav0wKxC5cqrIfNBWO8deY9PYLnQisF+",
+	"3w3linukUn5AXdP2ysS9mmFWzPWMxeYj+yn8LG3cvgP/VICNB0JdATtufJDjZF7YC0ytg3CQhOmALsfc",
+	"pFD98S71bYr3r1+/iCIAfrZRO8VB1O0123loP3BJ0arBAq5mYeHMmXmqsHAIB+I5pXDHnbfYaylrEsoN",
+	"Pp/NbaQZlrjr6opN3a3k5nKrlNbmCtD9y6ysOh6d++DaB9dyG/c3/m8Az+KfavGyAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
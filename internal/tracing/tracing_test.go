@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/basedalex/merch-shop/internal/config"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func TestQueryTracer(t *testing.T) {
+	recorder := recordSpans(t)
+	tracer := QueryTracer{}
+
+	ctx, parent := Tracer().Start(context.Background(), "GET /api/info")
+
+	queryCtx := tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{
+		SQL:  "\n\tSELECT balance FROM employees WHERE username = $1",
+		Args: []any{"alice"},
+	})
+	tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+
+	queryCtx = tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: "UPDATE employees SET balance = $1"})
+	tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{Err: errors.New("deadlock detected")})
+
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	query := spans[0]
+	assert.Equal(t, "db SELECT", query.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), query.Parent().SpanID(), "queries are children of the request span")
+	assert.Contains(t, query.Attributes(), attribute.String("db.query.text", "SELECT balance FROM employees WHERE username = $1"))
+	assert.Contains(t, query.Attributes(), attribute.Int64("db.rows_affected", 1))
+
+	for _, kv := range query.Attributes() {
+		assert.NotEqual(t, "alice", kv.Value.Emit(), "arguments are not recorded")
+	}
+
+	assert.Equal(t, "db UPDATE", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
+
+func TestSetupFileExporter(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	path := filepath.Join(t.TempDir(), "traces", "spans.json")
+
+	shutdown, err := Setup(context.Background(), config.Tracing{Exporter: ExporterFile, File: path})
+	require.NoError(t, err)
+
+	_, span := Tracer().Start(context.Background(), "GET /api/info")
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()))
+
+	spans, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(spans), `"Name":"GET /api/info"`)
+	assert.Contains(t, string(spans), `"Value":"merch-shop"`, "service name defaults to merch-shop")
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), config.Tracing{Exporter: "jaeger"})
+	assert.Error(t, err)
+
+	_, err = Setup(context.Background(), config.Tracing{Exporter: ExporterFile})
+	assert.Error(t, err, "the file exporter needs a file")
+}